@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+)
+
+// This file holds the plain DigitalOcean API calls shared by the TUI loaders
+// (which wrap them in tea.Cmds) and the non-interactive CLI subcommands.
+
+// dropletSpec describes a droplet to create
+type dropletSpec struct {
+	Name     string
+	Region   string
+	Size     string
+	Image    string
+	VPC      string // VPC UUID, empty for the region's default VPC
+	Tags     []string
+	SSHKeys  []string // SSH keys to install for root, by account key ID, fingerprint or name
+	Project  string   // Project name or ID to move the droplet into, empty for the account's default project
+	UserData string   // cloud-init config or script run on first boot
+}
+
+// parseTags splits a comma-separated tag list, dropping empty entries
+func parseTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func fetchDroplets(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Droplet, error) {
+	return listAll(ctx, defaultPerPage, client.Droplets.List, progress)
+}
+
+func fetchClusters(ctx context.Context, client *godo.Client, progress pageProgress) ([]*godo.KubernetesCluster, error) {
+	return listAll(ctx, defaultPerPage, client.Kubernetes.List, progress)
+}
+
+func fetchRegions(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Region, error) {
+	return listAll(ctx, defaultPerPage, client.Regions.List, progress)
+}
+
+func fetchSizes(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Size, error) {
+	return listAll(ctx, defaultPerPage, client.Sizes.List, progress)
+}
+
+func fetchImages(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Image, error) {
+	return listAll(ctx, defaultPerPage, client.Images.List, progress)
+}
+
+// fetchUserImages returns the account's private images: snapshots, backups and custom images
+func fetchUserImages(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Image, error) {
+	return listAll(ctx, defaultPerPage, client.Images.ListUser, progress)
+}
+
+func fetchDropletSnapshots(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Snapshot, error) {
+	return listAll(ctx, defaultPerPage, client.Snapshots.ListDroplet, progress)
+}
+
+func fetchVolumes(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Volume, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]godo.Volume, *godo.Response, error) {
+		return client.Storage.ListVolumes(ctx, &godo.ListVolumeParams{ListOptions: opt})
+	}, progress)
+}
+
+func fetchFirewalls(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Firewall, error) {
+	return listAll(ctx, defaultPerPage, client.Firewalls.List, progress)
+}
+
+func fetchLoadBalancers(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.LoadBalancer, error) {
+	return listAll(ctx, defaultPerPage, client.LoadBalancers.List, progress)
+}
+
+func fetchVPCs(ctx context.Context, client *godo.Client, progress pageProgress) ([]*godo.VPC, error) {
+	return listAll(ctx, defaultPerPage, client.VPCs.List, progress)
+}
+
+func fetchDomains(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Domain, error) {
+	return listAll(ctx, defaultPerPage, client.Domains.List, progress)
+}
+
+func fetchDomainRecords(ctx context.Context, client *godo.Client, domain string, progress pageProgress) ([]godo.DomainRecord, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+		return client.Domains.Records(ctx, domain, opt)
+	}, progress)
+}
+
+func fetchDatabases(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Database, error) {
+	return listAll(ctx, defaultPerPage, client.Databases.List, progress)
+}
+
+func fetchApps(ctx context.Context, client *godo.Client, progress pageProgress) ([]*godo.App, error) {
+	return listAll(ctx, defaultPerPage, client.Apps.List, progress)
+}
+
+func fetchAppDeployments(ctx context.Context, client *godo.Client, appID string, progress pageProgress) ([]*godo.Deployment, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]*godo.Deployment, *godo.Response, error) {
+		return client.Apps.ListDeployments(ctx, appID, opt)
+	}, progress)
+}
+
+// fetchRegistryRepos lists the registry's repositories. The endpoint takes page
+// tokens, but also accepts plain page numbers.
+func fetchRegistryRepos(ctx context.Context, client *godo.Client, registry string, progress pageProgress) ([]*godo.RepositoryV2, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+		return client.Registry.ListRepositoriesV2(ctx, registry, &godo.TokenListOptions{Page: opt.Page, PerPage: opt.PerPage})
+	}, progress)
+}
+
+func fetchRegistryTags(ctx context.Context, client *godo.Client, registry, repo string, progress pageProgress) ([]*godo.RepositoryTag, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error) {
+		return client.Registry.ListRepositoryTags(ctx, registry, repo, opt)
+	}, progress)
+}
+
+func fetchRegistryManifests(ctx context.Context, client *godo.Client, registry, repo string, progress pageProgress) ([]*godo.RepositoryManifest, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]*godo.RepositoryManifest, *godo.Response, error) {
+		return client.Registry.ListRepositoryManifests(ctx, registry, repo, opt)
+	}, progress)
+}
+
+func fetchGarbageCollections(ctx context.Context, client *godo.Client, registry string, progress pageProgress) ([]*godo.GarbageCollection, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error) {
+		return client.Registry.ListGarbageCollections(ctx, registry, opt)
+	}, progress)
+}
+
+func fetchProjects(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Project, error) {
+	return listAll(ctx, defaultPerPage, client.Projects.List, progress)
+}
+
+func fetchProjectResources(ctx context.Context, client *godo.Client, projectID string, progress pageProgress) ([]godo.ProjectResource, error) {
+	return listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]godo.ProjectResource, *godo.Response, error) {
+		return client.Projects.ListResources(ctx, projectID, opt)
+	}, progress)
+}
+
+// findProject returns the project with the ID, or the name (case-insensitive)
+func findProject(ctx context.Context, client *godo.Client, ref string) (*godo.Project, error) {
+	projects, err := fetchProjects(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range projects {
+		if projects[i].ID == ref {
+			return &projects[i], nil
+		}
+	}
+	for i := range projects {
+		if strings.EqualFold(projects[i].Name, ref) {
+			return &projects[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project %q: %w", ref, errNotFound)
+}
+
+func fetchSSHKeys(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.Key, error) {
+	return listAll(ctx, defaultPerPage, client.Keys.List, progress)
+}
+
+// resolveSSHKeys turns key IDs, fingerprints and names into droplet create keys.
+// Names are looked up in the account, IDs and fingerprints are passed as is.
+func resolveSSHKeys(ctx context.Context, client *godo.Client, refs []string) ([]godo.DropletCreateSSHKey, error) {
+	var keys []godo.DropletCreateSSHKey
+	var accountKeys []godo.Key
+	for _, ref := range refs {
+		if id, err := strconv.Atoi(ref); err == nil {
+			keys = append(keys, godo.DropletCreateSSHKey{ID: id})
+			continue
+		}
+		if strings.Contains(ref, ":") {
+			keys = append(keys, godo.DropletCreateSSHKey{Fingerprint: ref})
+			continue
+		}
+		if accountKeys == nil {
+			var err error
+			if accountKeys, err = fetchSSHKeys(ctx, client, nil); err != nil {
+				return nil, fmt.Errorf("failed to list SSH keys: %w", err)
+			}
+		}
+		key := findSSHKey(accountKeys, ref)
+		if key == nil {
+			return nil, fmt.Errorf("SSH key %q: %w", ref, errNotFound)
+		}
+		keys = append(keys, godo.DropletCreateSSHKey{ID: key.ID})
+	}
+	return keys, nil
+}
+
+// findSSHKey returns the key with the ID, fingerprint or name
+func findSSHKey(keys []godo.Key, ref string) *godo.Key {
+	for i, k := range keys {
+		if strconv.Itoa(k.ID) == ref || k.Fingerprint == ref || k.Name == ref {
+			return &keys[i]
+		}
+	}
+	return nil
+}
+
+func fetchBalance(ctx context.Context, client *godo.Client) (*godo.Balance, error) {
+	balance, _, err := client.Balance.Get(ctx)
+	return balance, err
+}
+
+// fetchInvoices returns all invoices, with the current month's preview first
+func fetchInvoices(ctx context.Context, client *godo.Client, progress pageProgress) ([]godo.InvoiceListItem, error) {
+	var preview *godo.InvoiceListItem
+	invoices, err := listAll(ctx, 50, func(ctx context.Context, opt *godo.ListOptions) ([]godo.InvoiceListItem, *godo.Response, error) {
+		invoiceList, resp, err := client.Invoices.List(ctx, opt)
+		if err != nil || invoiceList == nil {
+			return nil, resp, err
+		}
+		// The preview is only included on the first page
+		if opt.Page == 1 && invoiceList.InvoicePreview.InvoiceUUID != "" {
+			preview = &invoiceList.InvoicePreview
+		}
+		return invoiceList.Invoices, resp, nil
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	if preview != nil {
+		invoices = append([]godo.InvoiceListItem{*preview}, invoices...)
+	}
+	return invoices, nil
+}
+
+func fetchBillingHistory(ctx context.Context, client *godo.Client, progress pageProgress) (*godo.BillingHistory, error) {
+	entries, err := listAll(ctx, 100, func(ctx context.Context, opt *godo.ListOptions) ([]godo.BillingHistoryEntry, *godo.Response, error) {
+		history, resp, err := client.BillingHistory.List(ctx, opt)
+		if err != nil || history == nil {
+			return nil, resp, err
+		}
+		return history.BillingHistory, resp, nil
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+	return &godo.BillingHistory{BillingHistory: entries}, nil
+}
+
+// projectMoveError is returned with a created droplet that couldn't be moved
+// into its project. The droplet exists, so callers must not treat it as a
+// failed create.
+type projectMoveError struct {
+	name    string
+	project string
+	err     error
+}
+
+func (e *projectMoveError) Error() string {
+	return fmt.Sprintf("droplet %s created, but not moved to project %s: %v", e.name, e.project, e.err)
+}
+
+func (e *projectMoveError) Unwrap() error { return e.err }
+
+// createDropletFromSpec validates the spec and creates the droplet
+func createDropletFromSpec(ctx context.Context, client *godo.Client, spec dropletSpec) (*godo.Droplet, error) {
+	if spec.Name == "" || spec.Region == "" || spec.Size == "" || spec.Image == "" {
+		return nil, fmt.Errorf("name, region, size, and image are required")
+	}
+	if err := validateUserData(spec.UserData); err != nil {
+		return nil, err
+	}
+
+	// Public images are referenced by slug, snapshots and custom images by numeric ID
+	image := godo.DropletCreateImage{Slug: spec.Image}
+	if id, err := strconv.Atoi(spec.Image); err == nil {
+		image = godo.DropletCreateImage{ID: id}
+	}
+
+	sshKeys, err := resolveSSHKeys(ctx, client, spec.SSHKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	createRequest := &godo.DropletCreateRequest{
+		Name:     spec.Name,
+		Region:   spec.Region,
+		Size:     spec.Size,
+		Image:    image,
+		IPv6:     true,
+		Tags:     spec.Tags,
+		VPCUUID:  spec.VPC,
+		SSHKeys:  sshKeys,
+		UserData: spec.UserData,
+	}
+
+	droplet, _, err := client.Droplets.Create(ctx, createRequest)
+	if err != nil {
+		// Add context about what was being created (wrapped so 401s are still detected)
+		return nil, fmt.Errorf("failed to create droplet: %w (region: %s, size: %s, image: %s)",
+			err, spec.Region, spec.Size, spec.Image)
+	}
+
+	// New droplets land in the default project, so move them afterwards
+	if spec.Project != "" {
+		project, err := findProject(ctx, client, spec.Project)
+		if err == nil && !project.IsDefault {
+			_, _, err = client.Projects.AssignResources(ctx, project.ID, droplet.URN())
+		}
+		if err != nil {
+			return droplet, &projectMoveError{name: droplet.Name, project: spec.Project, err: err}
+		}
+	}
+	return droplet, nil
+}
+
+func destroyDroplet(ctx context.Context, client *godo.Client, id int) error {
+	_, err := client.Droplets.Delete(ctx, id)
+	return err
+}
@@ -0,0 +1,623 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// appLogTailLines is how many lines of each log are loaded
+const appLogTailLines = 1000
+
+type appsLoadedMsg []*godo.App
+
+// appDeploymentsLoadedMsg carries an app's deployment history, newest first
+type appDeploymentsLoadedMsg struct {
+	appID       string
+	deployments []*godo.Deployment
+}
+
+// appChangedMsg reports a triggered deployment or rollback
+type appChangedMsg struct {
+	appID   string
+	message string
+}
+
+// appComponent is a row in an app's components list
+type appComponent struct {
+	name      string
+	kind      godo.AppComponentType
+	source    string
+	instances string // e.g. "2 × apps-s-1vcpu-1gb", "-" for static sites
+	routes    string
+}
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewApps,
+		aliases: []string{"app"},
+		title:   "Apps",
+		load: func(m *model) tea.Cmd {
+			if m.selectedApp != nil {
+				return tea.Batch(loadApps(m.client, m.pageProgressChan), loadAppDeployments(m.client, m.selectedApp.ID, m.pageProgressChan))
+			}
+			return loadApps(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			if m.selectedApp == nil {
+				return len(m.projectApps())
+			}
+			if m.appResourceType == "components" {
+				return len(appComponents(m.selectedApp))
+			}
+			return len(m.appDeployments)
+		},
+		updateTable: (*model).updateAppTable,
+		handleKey:   (*model).handleAppKey,
+		keys: []keyHint{
+			{"t", "Deployments/Components"},
+			{"l", "Logs"},
+			{"d", "Deploy"},
+			{"b", "Rollback"},
+		},
+		renderDetails: model.renderAppDetails,
+	})
+}
+
+func loadApps(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		apps, err := fetchApps(context.Background(), client, reportProgress(progressChan, client, "apps"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return appsLoadedMsg(apps)
+	})
+}
+
+func loadAppDeployments(client *godo.Client, appID string, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		deployments, err := fetchAppDeployments(context.Background(), client, appID, reportProgress(progressChan, client, "deployments"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return appDeploymentsLoadedMsg{appID: appID, deployments: deployments}
+	})
+}
+
+func createAppDeployment(client *godo.Client, app *godo.App, forceBuild bool) tea.Cmd {
+	return func() tea.Msg {
+		deployment, _, err := client.Apps.CreateDeployment(context.Background(), app.ID, &godo.DeploymentCreateRequest{ForceBuild: forceBuild})
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to deploy %s: %w", appName(app), err))
+		}
+		return appChangedMsg{appID: app.ID, message: fmt.Sprintf("Started deployment %s of %s", shortID(deployment.ID), appName(app))}
+	}
+}
+
+// appRollbackRequest is the body of POST /v2/apps/{id}/rollback, which godo doesn't wrap
+type appRollbackRequest struct {
+	DeploymentID string `json:"deployment_id"`
+	SkipPin      bool   `json:"skip_pin"`
+}
+
+// rollbackApp redeploys an earlier deployment. The rollback isn't pinned, so
+// later pushes deploy as usual without committing or reverting the rollback.
+func rollbackApp(client *godo.Client, app *godo.App, deployment *godo.Deployment) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		req, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("v2/apps/%s/rollback", app.ID), &appRollbackRequest{DeploymentID: deployment.ID, SkipPin: true})
+		if err != nil {
+			return errMsg(err)
+		}
+		if _, err := client.Do(ctx, req, nil); err != nil {
+			return errMsg(fmt.Errorf("failed to roll back %s: %w", appName(app), err))
+		}
+		return appChangedMsg{appID: app.ID, message: fmt.Sprintf("Rolling back %s to deployment %s", appName(app), shortID(deployment.ID))}
+	}
+}
+
+// appLogStream returns a stream of a build, deploy or run log. An empty
+// component streams the logs of all components. The live log starts with the
+// last appLogTailLines lines and follows new ones; logs without one, e.g. of
+// a finished build, are downloaded once.
+func appLogStream(client *godo.Client, appID, deploymentID, component string, logType godo.AppLogType) func(ctx context.Context, emit func(line string)) error {
+	return func(ctx context.Context, emit func(line string)) error {
+		logs, _, err := client.Apps.GetLogs(ctx, appID, deploymentID, component, logType, true, appLogTailLines)
+		if err != nil {
+			return fmt.Errorf("failed to get %s logs: %w", strings.ToLower(string(logType)), err)
+		}
+		urls := logs.HistoricURLs
+		if logs.LiveURL != "" {
+			urls = []string{logs.LiveURL}
+		}
+		for _, u := range urls {
+			if err := streamAppLog(ctx, u, emit); err != nil {
+				if ctx.Err() != nil {
+					return nil // Stopped, not failed
+				}
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// streamAppLog emits the lines of the log at the URL until it ends
+func streamAppLog(ctx context.Context, u string, emit func(line string)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download logs: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to download logs: %s", resp.Status)
+	}
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		emit(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read logs: %w", err)
+	}
+	return nil
+}
+
+// appName returns the app's name from its spec
+func appName(app *godo.App) string {
+	if app.Spec != nil {
+		return app.Spec.Name
+	}
+	return app.ID
+}
+
+// shortID returns the first 8 characters of an ID, like the control panel
+func shortID(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
+
+// appPhase returns the phase of the app's in-progress deployment, or of its active one
+func appPhase(app *godo.App) string {
+	switch {
+	case app.InProgressDeployment != nil:
+		return string(app.InProgressDeployment.Phase)
+	case app.PendingDeployment != nil:
+		return string(app.PendingDeployment.Phase)
+	case app.ActiveDeployment != nil:
+		return string(app.ActiveDeployment.Phase)
+	}
+	return "-"
+}
+
+// appComponentSource describes where a component is built from, e.g. "github.com/org/repo@main"
+func appComponentSource(c godo.AppComponentSpec) string {
+	if container, ok := c.(godo.AppContainerComponentSpec); ok {
+		if image := container.GetImage(); image != nil {
+			ref := image.Repository
+			if image.Registry != "" {
+				ref = image.Registry + "/" + ref
+			}
+			if image.Digest != "" {
+				return ref + "@" + image.Digest
+			}
+			tag := image.Tag
+			if tag == "" {
+				tag = "latest"
+			}
+			return ref + ":" + tag
+		}
+	}
+	if buildable, ok := c.(godo.AppBuildableComponentSpec); ok {
+		switch {
+		case buildable.GetGitHub() != nil:
+			return fmt.Sprintf("github.com/%s@%s", buildable.GetGitHub().Repo, buildable.GetGitHub().Branch)
+		case buildable.GetGitLab() != nil:
+			return fmt.Sprintf("gitlab.com/%s@%s", buildable.GetGitLab().Repo, buildable.GetGitLab().Branch)
+		case buildable.GetBitbucket() != nil:
+			return fmt.Sprintf("bitbucket.org/%s@%s", buildable.GetBitbucket().Repo, buildable.GetBitbucket().Branch)
+		case buildable.GetGit() != nil:
+			return fmt.Sprintf("%s@%s", buildable.GetGit().RepoCloneURL, buildable.GetGit().Branch)
+		}
+	}
+	return "-"
+}
+
+// appComponents lists the components in the app's spec
+func appComponents(app *godo.App) []appComponent {
+	if app == nil || app.Spec == nil {
+		return nil
+	}
+	var components []appComponent
+	_ = godo.ForEachAppSpecComponent(app.Spec, func(c godo.AppComponentSpec) error {
+		component := appComponent{name: c.GetName(), kind: c.GetType(), source: appComponentSource(c), instances: "-", routes: "-"}
+		if container, ok := c.(godo.AppContainerComponentSpec); ok {
+			component.instances = fmt.Sprintf("%d × %s", max(int(container.GetInstanceCount()), 1), container.GetInstanceSizeSlug())
+		}
+		if routable, ok := c.(godo.AppRoutableComponentSpec); ok && len(routable.GetRoutes()) > 0 {
+			var paths []string
+			for _, route := range routable.GetRoutes() {
+				paths = append(paths, route.Path)
+			}
+			component.routes = strings.Join(paths, ", ")
+		}
+		components = append(components, component)
+		return nil
+	})
+	return components
+}
+
+// appDeploymentComponents returns the names of the components a deployment ran
+func appDeploymentComponents(d *godo.Deployment) []string {
+	var names []string
+	if d.Spec != nil {
+		_ = godo.ForEachAppSpecComponent(d.Spec, func(c godo.AppComponentSpec) error {
+			names = append(names, c.GetName())
+			return nil
+		})
+	}
+	return names
+}
+
+// selectedAppDeployment returns the deployment under the cursor
+func (m *model) selectedAppDeployment() *godo.Deployment {
+	if m.selectedApp == nil || m.appResourceType == "components" {
+		return nil
+	}
+	i := m.selectedIndex(len(m.appDeployments))
+	if i < 0 {
+		return nil
+	}
+	return m.appDeployments[i]
+}
+
+// selectedAppComponent returns the component under the cursor
+func (m *model) selectedAppComponent() (appComponent, bool) {
+	if m.selectedApp == nil || m.appResourceType != "components" {
+		return appComponent{}, false
+	}
+	components := appComponents(m.selectedApp)
+	i := m.selectedIndex(len(components))
+	if i < 0 {
+		return appComponent{}, false
+	}
+	return components[i], true
+}
+
+// highlightedApp returns the app being browsed, or the app under the cursor in the app list
+func (m *model) highlightedApp() *godo.App {
+	if m.selectedApp != nil {
+		return m.selectedApp
+	}
+	apps := m.projectApps()
+	i := m.selectedIndex(len(apps))
+	if i < 0 {
+		return nil
+	}
+	return apps[i]
+}
+
+func (m *model) updateAppTable() {
+	if m.selectedApp == nil {
+		columns := responsiveColumns(m.width, []columnSpec{
+			{"NAME", 0.18, 12},
+			{"LIVE URL", 0.32, 20},
+			{"REGION", 0.08, 6},
+			{"ACTIVE DEPLOYMENT", 0.14, 10},
+			{"PHASE", 0.12, 8},
+			{"UPDATED", 0.16, 10},
+		})
+		var rows []table.Row
+		for _, app := range m.projectApps() {
+			region, active := "-", "-"
+			if app.Region != nil {
+				region = app.Region.Slug
+			}
+			if app.ActiveDeployment != nil {
+				active = shortID(app.ActiveDeployment.ID)
+			}
+			liveURL := app.LiveURL
+			if liveURL == "" {
+				liveURL = "-"
+			}
+			rows = append(rows, table.Row{appName(app), liveURL, region, active, appPhase(app), app.UpdatedAt.Local().Format("2006-01-02 15:04")})
+		}
+		m.setTableData(columns, rows)
+		return
+	}
+
+	if m.appResourceType == "components" {
+		columns := responsiveColumns(m.width, []columnSpec{
+			{"NAME", 0.16, 10},
+			{"TYPE", 0.10, 8},
+			{"SOURCE", 0.38, 20},
+			{"INSTANCES", 0.20, 10},
+			{"ROUTES", 0.16, 8},
+		})
+		var rows []table.Row
+		for _, c := range appComponents(m.selectedApp) {
+			rows = append(rows, table.Row{c.name, string(c.kind), c.source, c.instances, c.routes})
+		}
+		m.setTableData(columns, rows)
+		return
+	}
+
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"ID", 0.10, 8},
+		{"CAUSE", 0.40, 20},
+		{"PHASE", 0.14, 8},
+		{"PROGRESS", 0.10, 8},
+		{"CREATED", 0.16, 10},
+		{"ACTIVE", 0.10, 6},
+	})
+	var rows []table.Row
+	for _, d := range m.appDeployments {
+		progress := "-"
+		if d.Progress != nil && d.Progress.TotalSteps > 0 {
+			progress = fmt.Sprintf("%d/%d", d.Progress.SuccessSteps, d.Progress.TotalSteps)
+		}
+		active := ""
+		if m.selectedApp.ActiveDeployment != nil && m.selectedApp.ActiveDeployment.ID == d.ID {
+			active = "●"
+		}
+		rows = append(rows, table.Row{shortID(d.ID), d.Cause, string(d.Phase), progress, d.CreatedAt.Local().Format("2006-01-02 15:04"), active})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleAppKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "enter":
+		if m.selectedApp != nil {
+			return nil, false // Deployment or component details
+		}
+		app := m.highlightedApp()
+		if app == nil {
+			return nil, true
+		}
+		m.selectedApp = app
+		m.appResourceType = "deployments"
+		m.appDeployments = nil
+		m.loading = true
+		m.table.SetCursor(0)
+		m.updateTableRows()
+		return tea.Batch(loadAppDeployments(m.client, app.ID, m.pageProgressChan), m.spinner.Tick), true
+
+	case "esc":
+		if m.selectedApp == nil {
+			return nil, false
+		}
+		// Back to the app list, with the cursor on the app
+		appID := m.selectedApp.ID
+		m.selectedApp = nil
+		m.appDeployments = nil
+		m.updateTableRows()
+		for i, app := range m.projectApps() {
+			if app.ID == appID {
+				m.table.SetCursor(i)
+				break
+			}
+		}
+		return nil, true
+
+	case "t", "T":
+		if m.selectedApp == nil {
+			return nil, true
+		}
+		if m.appResourceType == "components" {
+			m.appResourceType = "deployments"
+		} else {
+			m.appResourceType = "components"
+		}
+		m.table.SetCursor(0)
+		m.updateTableRows()
+		return nil, true
+
+	case "l", "L":
+		app := m.highlightedApp()
+		if app == nil {
+			return nil, true
+		}
+		m.openAppLogPicker(app)
+		return nil, true
+
+	case "d", "D":
+		app := m.highlightedApp()
+		if app == nil {
+			return nil, true
+		}
+		m.picker = &pickerDialog{
+			title: fmt.Sprintf("🚀 Deploy %s", appName(app)),
+			options: []pickerOption{
+				{label: "Deploy (reuse the build cache)", value: "deploy"},
+				{label: "Force rebuild and deploy", value: "force"},
+			},
+			onPick: func(m *model, option pickerOption) tea.Cmd {
+				m.confirm = &confirmDialog{
+					title: "Deploy App?",
+					lines: []string{fmt.Sprintf("App: %s", appName(app)), fmt.Sprintf("Build: %s", option.label)},
+					onConfirm: func(m *model) tea.Cmd {
+						m.loading = true
+						return tea.Batch(createAppDeployment(m.client, app, option.value == "force"), m.spinner.Tick)
+					},
+				}
+				return nil
+			},
+		}
+		return nil, true
+
+	case "b", "B":
+		d := m.selectedAppDeployment()
+		if d == nil {
+			m.err = fmt.Errorf("rollback: open the app and select a deployment to roll back to")
+			return nil, true
+		}
+		app := m.selectedApp
+		if app.ActiveDeployment != nil && app.ActiveDeployment.ID == d.ID {
+			m.err = fmt.Errorf("rollback: deployment %s is already active", shortID(d.ID))
+			return nil, true
+		}
+		if d.Phase != godo.DeploymentPhase_Superseded {
+			m.err = fmt.Errorf("rollback: only earlier successful deployments can be rolled back to (this one is %s)", d.Phase)
+			return nil, true
+		}
+		m.confirm = &confirmDialog{
+			title: "Roll Back App?",
+			lines: []string{
+				fmt.Sprintf("App: %s", appName(app)),
+				fmt.Sprintf("To deployment: %s (%s)", shortID(d.ID), d.CreatedAt.Local().Format("2006-01-02 15:04")),
+				fmt.Sprintf("Cause: %s", d.Cause),
+			},
+			warning: "The app's code and settings are redeployed as they were in this deployment.",
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(rollbackApp(m.client, app, d), m.spinner.Tick)
+			},
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// openAppLogPicker asks which log to show, then which component, and opens the log pane.
+// Build and deploy logs come from the selected deployment (or the active one);
+// run logs always come from the running deployment.
+func (m *model) openAppLogPicker(app *godo.App) {
+	deployment := m.selectedAppDeployment()
+	if deployment == nil {
+		deployment = app.InProgressDeployment
+	}
+	if deployment == nil {
+		deployment = app.ActiveDeployment
+	}
+	component, hasComponent := m.selectedAppComponent()
+
+	m.picker = &pickerDialog{
+		title: fmt.Sprintf("📜 Logs for %s", appName(app)),
+		options: []pickerOption{
+			{label: "Run logs", value: string(godo.AppLogTypeRun)},
+			{label: "Build logs", value: string(godo.AppLogTypeBuild)},
+			{label: "Deploy logs", value: string(godo.AppLogTypeDeploy)},
+		},
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			logType := godo.AppLogType(option.value)
+			deploymentID := ""
+			if logType != godo.AppLogTypeRun {
+				if deployment == nil {
+					m.err = fmt.Errorf("logs: %s has no deployments yet", appName(app))
+					return nil
+				}
+				deploymentID = deployment.ID
+			}
+			open := func(m *model, name string) tea.Cmd {
+				title := fmt.Sprintf("📜 %s %s logs", appName(app), strings.ToLower(option.value))
+				if name != "" {
+					title += " - " + name
+				}
+				if deploymentID != "" {
+					title += fmt.Sprintf(" (deployment %s)", shortID(deploymentID))
+				}
+				return m.openLogStream(title, appLogStream(m.client, app.ID, deploymentID, name, logType))
+			}
+			if hasComponent {
+				return open(m, component.name)
+			}
+
+			var names []string
+			if deployment != nil && deploymentID != "" {
+				names = appDeploymentComponents(deployment)
+			} else {
+				for _, c := range appComponents(app) {
+					names = append(names, c.name)
+				}
+			}
+			if len(names) <= 1 {
+				return open(m, "")
+			}
+			options := []pickerOption{{label: "All components", value: ""}}
+			for _, name := range names {
+				options = append(options, pickerOption{label: name, value: name})
+			}
+			m.picker = &pickerDialog{
+				title:   "📜 Component",
+				options: options,
+				onPick: func(m *model, option pickerOption) tea.Cmd {
+					return open(m, option.value)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+// renderDeploymentSteps renders the deployment's progress steps as an indented tree
+func renderDeploymentSteps(steps []*godo.DeploymentProgressStep, indent string, pairs [][2]string) [][2]string {
+	for _, step := range steps {
+		name := step.Name
+		if step.MessageBase != "" {
+			name = strings.TrimSpace(step.MessageBase + " " + step.ComponentName)
+		}
+		value := string(step.Status)
+		if !step.StartedAt.IsZero() && !step.EndedAt.IsZero() {
+			value += fmt.Sprintf(" (%s)", step.EndedAt.Sub(step.StartedAt).Round(time.Second))
+		}
+		if step.Reason != nil && step.Reason.Message != "" {
+			value += " - " + step.Reason.Message
+		}
+		pairs = append(pairs, [2]string{indent + name, value})
+		pairs = renderDeploymentSteps(step.Steps, indent+"  ", pairs)
+	}
+	return pairs
+}
+
+func (m model) renderAppDetails() string {
+	if d := m.selectedAppDeployment(); d != nil {
+		var b strings.Builder
+		// Note: Top padding is applied globally in View(), not here
+		b.WriteString(headerStyle.Render(fmt.Sprintf("🚀 Deployment %s of %s", shortID(d.ID), appName(m.selectedApp))))
+		b.WriteString("\n\n")
+		b.WriteString(renderDetailsSection("Deployment", [][2]string{
+			{"ID", d.ID},
+			{"Phase", string(d.Phase)},
+			{"Cause", d.Cause},
+			{"Created", d.CreatedAt.Local().Format("2006-01-02 15:04:05")},
+			{"Updated", d.UpdatedAt.Local().Format("2006-01-02 15:04:05")},
+			{"Components", strings.Join(appDeploymentComponents(d), ", ")},
+		}))
+		b.WriteString("\n")
+		if d.Progress != nil {
+			b.WriteString(renderDetailsSection("Steps", renderDeploymentSteps(d.Progress.Steps, "", nil)))
+		}
+		return b.String()
+	}
+
+	c, ok := m.selectedAppComponent()
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("🧩 Component %s of %s", c.name, appName(m.selectedApp))))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("Component", [][2]string{
+		{"Name", c.name},
+		{"Type", string(c.kind)},
+		{"Source", c.source},
+		{"Instances", c.instances},
+		{"Routes", c.routes},
+	}))
+	return b.String()
+}
@@ -0,0 +1,563 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"gopkg.in/yaml.v3"
+)
+
+// Exit codes for non-interactive subcommands
+const (
+	exitOK       = 0 // Success
+	exitError    = 1 // API or other runtime error
+	exitUsage    = 2 // Bad arguments or flags
+	exitAuth     = 3 // Missing, invalid or expired token
+	exitNotFound = 4 // Requested resource does not exist
+	exitWarning  = 5 // Done, but a follow-up step failed
+)
+
+// dropletWaitTimeout bounds how long "droplets create --wait" polls for the droplet to become active
+const dropletWaitTimeout = 5 * time.Minute
+
+// usageError marks errors caused by bad command-line input
+type usageError struct {
+	msg string
+}
+
+func (e usageError) Error() string { return e.msg }
+
+// cliWarning marks errors of a follow-up step after the command itself succeeded
+// and printed its result, e.g. a created droplet that wasn't moved to its project
+type cliWarning struct {
+	err error
+}
+
+func (e cliWarning) Error() string { return e.err.Error() }
+func (e cliWarning) Unwrap() error { return e.err }
+
+// errNotFound is wrapped by lookups that don't find the requested resource
+var errNotFound = errors.New("not found")
+
+// cliEnv is what every subcommand gets to work with
+type cliEnv struct {
+	client  *godo.Client
+	config  *Config
+	context *Context
+	stdout  io.Writer
+}
+
+// cliCommand is a non-interactive subcommand such as "droplets list"
+type cliCommand struct {
+	group   string
+	name    string
+	summary string
+	run     func(env *cliEnv, args []string) error
+}
+
+var cliCommands = []cliCommand{
+	{"droplets", "list", "List droplets", runDropletsList},
+	{"droplets", "create", "Create a droplet (defaults from config.yaml)", runDropletsCreate},
+	{"droplets", "delete", "Delete a droplet by ID or name", runDropletsDelete},
+	{"clusters", "list", "List Kubernetes clusters", runClustersList},
+	{"billing", "balance", "Show the account balance", runBillingBalance},
+	{"billing", "invoices", "List invoices", runBillingInvoices},
+	{"billing", "history", "Show billing history", runBillingHistory},
+}
+
+// printUsage prints top-level usage, including the subcommands
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: dogoctl [--context name] [command] [flags]\n\n")
+	fmt.Fprintf(w, "Without a command dogoctl starts the interactive TUI.\n\n")
+	fmt.Fprintf(w, "Commands:\n")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range cliCommands {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", c.group, c.name, c.summary)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\nGlobal flags:\n")
+	flag.CommandLine.SetOutput(w)
+	flag.PrintDefaults()
+	fmt.Fprintf(w, "\nRun 'dogoctl <command> -h' for command flags. Output format is set with -o table|json|yaml.\n")
+}
+
+// runCLI runs a subcommand and returns the process exit code
+func runCLI(args []string, cfg *Config, cfgErr error, contextFlag string) int {
+	if args[0] == "help" {
+		printUsage(os.Stdout)
+		return exitOK
+	}
+
+	var command *cliCommand
+	for i := range cliCommands {
+		if cliCommands[i].group == args[0] && len(args) > 1 && cliCommands[i].name == args[1] {
+			command = &cliCommands[i]
+			break
+		}
+	}
+	if command == nil {
+		fmt.Fprintf(os.Stderr, "dogoctl: unknown command %q\n\n", strings.Join(args[:min(len(args), 2)], " "))
+		printUsage(os.Stderr)
+		return exitUsage
+	}
+
+	if cfgErr != nil {
+		fmt.Fprintf(os.Stderr, "dogoctl: warning: %v\n", cfgErr)
+	}
+
+	contextName, activeContext, err := cfg.resolveContext(contextFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dogoctl: %v\n", err)
+		return exitUsage
+	}
+	token, _, err := activeContext.token()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dogoctl: context %s: %v\n", contextName, err)
+		return exitAuth
+	}
+
+	env := &cliEnv{
+		client:  newGodoClient(token),
+		config:  cfg,
+		context: activeContext,
+		stdout:  os.Stdout,
+	}
+	if err := command.run(env, args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		var warning cliWarning
+		if errors.As(err, &warning) {
+			fmt.Fprintf(os.Stderr, "dogoctl: warning: %v\n", err)
+		} else if isAuthError(err) {
+			fmt.Fprintf(os.Stderr, "dogoctl: API token invalid or expired (context %s): %v\n", contextName, err)
+		} else {
+			fmt.Fprintf(os.Stderr, "dogoctl: %v\n", err)
+		}
+		return exitCode(err)
+	}
+	return exitOK
+}
+
+// exitCode maps an error to the process exit code
+func exitCode(err error) int {
+	var uerr usageError
+	var warning cliWarning
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &warning):
+		// Checked first, as the wrapped error may be a 401 or 404
+		return exitWarning
+	case errors.As(err, &uerr):
+		return exitUsage
+	case isAuthError(err):
+		return exitAuth
+	case isNotFoundError(err):
+		return exitNotFound
+	default:
+		return exitError
+	}
+}
+
+// isNotFoundError reports whether err is a 404 from the API or a failed lookup
+func isNotFoundError(err error) bool {
+	if errors.Is(err, errNotFound) {
+		return true
+	}
+	var errResp *godo.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// newFlagSet returns a flag set for a subcommand with the shared -o/--output flag
+func newFlagSet(name string, output *string) *flag.FlagSet {
+	fs := flag.NewFlagSet("dogoctl "+name, flag.ContinueOnError)
+	fs.StringVar(output, "o", "table", "output format: table, json or yaml")
+	fs.StringVar(output, "output", "table", "output format: table, json or yaml")
+	return fs
+}
+
+// parseFlags parses flags that may appear before or after positional
+// arguments and validates -o, returning the positional arguments
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageError{msg: err.Error()}
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	// -o and --output share a variable, so either flag's value will do
+	if err := checkOutputFormat(fs.Lookup("o").Value.String()); err != nil {
+		return nil, err
+	}
+	return positional, nil
+}
+
+// checkOutputFormat validates the -o value before any API call is made
+func checkOutputFormat(format string) error {
+	switch format {
+	case "table", "json", "yaml":
+		return nil
+	}
+	return usageError{msg: fmt.Sprintf("unknown output format %q (want table, json or yaml)", format)}
+}
+
+// writeOutput renders v as JSON or YAML, or calls table to print a table
+func writeOutput(w io.Writer, format string, v interface{}, table func(tw *tabwriter.Writer)) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case "yaml":
+		// Go through JSON so field names match the API (godo only has json tags)
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		var node yaml.Node
+		if err := yaml.Unmarshal(data, &node); err != nil {
+			return err
+		}
+		resetYAMLStyle(&node)
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(&node); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		table(tw)
+		return tw.Flush()
+	}
+}
+
+// resetYAMLStyle switches nodes parsed from JSON to block style
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+func runDropletsList(env *cliEnv, args []string) error {
+	var output, tag, region string
+	fs := newFlagSet("droplets list", &output)
+	fs.StringVar(&tag, "tag", "", "only list droplets with this tag")
+	fs.StringVar(&region, "region", "", "only list droplets in this region")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	droplets, err := fetchDroplets(context.Background(), env.client, nil)
+	if err != nil {
+		return err
+	}
+	filtered := []godo.Droplet{}
+	for _, d := range droplets {
+		if region != "" && (d.Region == nil || d.Region.Slug != region) {
+			continue
+		}
+		if tag != "" && !containsString(d.Tags, tag) {
+			continue
+		}
+		filtered = append(filtered, d)
+	}
+
+	return writeOutput(env.stdout, output, filtered, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "ID\tNAME\tSTATUS\tREGION\tSIZE\tPUBLIC IP\tTAGS")
+		for _, d := range filtered {
+			regionSlug := ""
+			if d.Region != nil {
+				regionSlug = d.Region.Slug
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				d.ID, d.Name, d.Status, regionSlug, d.SizeSlug, getPublicIP(d), strings.Join(d.Tags, ","))
+		}
+	})
+}
+
+func runDropletsCreate(env *cliEnv, args []string) error {
+	// Defaults come from the droplet template, same as the TUI create form
+	region := env.config.Region
+	if env.context.DefaultRegion != "" {
+		region = env.context.DefaultRegion
+	}
+
+	var output, name, size, image, vpc, tags, sshKeys, project, userData string
+	var wait bool
+	fs := newFlagSet("droplets create", &output)
+	fs.StringVar(&name, "name", env.config.DropletName, "droplet name")
+	fs.StringVar(&region, "region", region, "region slug")
+	fs.StringVar(&size, "size", env.config.Size, "size slug")
+	fs.StringVar(&image, "image", env.config.ImageSlug, "image slug, or snapshot/custom image ID")
+	fs.StringVar(&vpc, "vpc", "", "VPC UUID (default: the region's default VPC)")
+	fs.StringVar(&tags, "tags", strings.Join(env.config.Tags, ","), "comma-separated tags")
+	fs.StringVar(&sshKeys, "ssh-keys", strings.Join(env.config.SSHKeys, ","), "comma-separated SSH key names, IDs or fingerprints")
+	fs.StringVar(&project, "project", env.context.DefaultProject, "project name or ID (default: the account's default project)")
+	fs.StringVar(&userData, "user-data", "", "cloud-init file, or the name of a template in the config directory's cloud-init/")
+	fs.BoolVar(&wait, "wait", false, "wait until the droplet is active")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	spec := dropletSpec{
+		Name:    strings.TrimSpace(name),
+		Region:  region,
+		Size:    size,
+		Image:   image,
+		VPC:     vpc,
+		Tags:    parseTags(tags),
+		SSHKeys: parseTags(sshKeys),
+		Project: project,
+	}
+	if spec.Name == "" || spec.Region == "" || spec.Size == "" || spec.Image == "" {
+		return usageError{msg: "--name, --region, --size and --image are required (or set them in config.yaml)"}
+	}
+	if userData != "" {
+		data, err := resolveUserData(userData)
+		if err != nil {
+			return usageError{msg: fmt.Sprintf("--user-data: %v", err)}
+		}
+		spec.UserData = data
+	}
+
+	ctx := context.Background()
+	droplet, err := createDropletFromSpec(ctx, env.client, spec)
+	// A failed project move still created the droplet, so print it for scripts
+	// to pick up its ID instead of retrying into a duplicate
+	var moveErr *projectMoveError
+	if err != nil && !errors.As(err, &moveErr) {
+		return err
+	}
+	if wait {
+		if droplet, err = waitForDropletActive(ctx, env.client, droplet.ID); err != nil {
+			return err
+		}
+	}
+
+	err = writeOutput(env.stdout, output, droplet, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "ID\tNAME\tSTATUS\tREGION\tSIZE\tPUBLIC IP")
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			droplet.ID, droplet.Name, droplet.Status, spec.Region, spec.Size, getPublicIP(*droplet))
+	})
+	if err == nil && moveErr != nil {
+		return cliWarning{err: moveErr}
+	}
+	return err
+}
+
+// waitForDropletActive polls the droplet until its status is active
+func waitForDropletActive(ctx context.Context, client *godo.Client, id int) (*godo.Droplet, error) {
+	ctx, cancel := context.WithTimeout(ctx, dropletWaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		droplet, _, err := client.Droplets.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if droplet.Status == "active" {
+			return droplet, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("droplet %d is still %s after %s", id, droplet.Status, dropletWaitTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+func runDropletsDelete(env *cliEnv, args []string) error {
+	var output string
+	var yes bool
+	fs := newFlagSet("droplets delete", &output)
+	fs.BoolVar(&yes, "yes", false, "confirm deletion (required)")
+	fs.BoolVar(&yes, "y", false, "shorthand for --yes")
+	positional, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageError{msg: "usage: dogoctl droplets delete <id|name> --yes"}
+	}
+
+	ctx := context.Background()
+	droplet, err := findDroplet(ctx, env.client, positional[0])
+	if err != nil {
+		return err
+	}
+	if !yes {
+		return usageError{msg: fmt.Sprintf("refusing to delete droplet %s (%d) without --yes", droplet.Name, droplet.ID)}
+	}
+	if err := destroyDroplet(ctx, env.client, droplet.ID); err != nil {
+		return err
+	}
+
+	result := map[string]interface{}{"id": droplet.ID, "name": droplet.Name, "deleted": true}
+	return writeOutput(env.stdout, output, result, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "Deleted droplet %s (%d)\n", droplet.Name, droplet.ID)
+	})
+}
+
+// findDroplet looks a droplet up by numeric ID or exact name
+func findDroplet(ctx context.Context, client *godo.Client, ref string) (*godo.Droplet, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		droplet, _, err := client.Droplets.Get(ctx, id)
+		return droplet, err
+	}
+
+	droplets, err := fetchDroplets(ctx, client, nil)
+	if err != nil {
+		return nil, err
+	}
+	var matches []godo.Droplet
+	for _, d := range droplets {
+		if d.Name == ref {
+			matches = append(matches, d)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("droplet %q: %w", ref, errNotFound)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, usageError{msg: fmt.Sprintf("%d droplets are named %q, use the ID instead", len(matches), ref)}
+	}
+}
+
+func runClustersList(env *cliEnv, args []string) error {
+	var output string
+	fs := newFlagSet("clusters list", &output)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	clusters, err := fetchClusters(context.Background(), env.client, nil)
+	if err != nil {
+		return err
+	}
+	if clusters == nil {
+		clusters = []*godo.KubernetesCluster{}
+	}
+
+	return writeOutput(env.stdout, output, clusters, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "ID\tNAME\tREGION\tVERSION\tSTATUS\tNODES")
+		for _, c := range clusters {
+			status := ""
+			if c.Status != nil {
+				status = string(c.Status.State)
+			}
+			nodes := 0
+			for _, pool := range c.NodePools {
+				nodes += pool.Count
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\n", c.ID, c.Name, c.RegionSlug, c.VersionSlug, status, nodes)
+		}
+	})
+}
+
+func runBillingBalance(env *cliEnv, args []string) error {
+	var output string
+	fs := newFlagSet("billing balance", &output)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	balance, err := fetchBalance(context.Background(), env.client)
+	if err != nil {
+		return err
+	}
+
+	return writeOutput(env.stdout, output, balance, func(tw *tabwriter.Writer) {
+		fmt.Fprintf(tw, "Month-to-date usage:\t$%s\n", balance.MonthToDateUsage)
+		fmt.Fprintf(tw, "Month-to-date balance:\t$%s\n", balance.MonthToDateBalance)
+		fmt.Fprintf(tw, "Account balance:\t$%s\n", balance.AccountBalance)
+		fmt.Fprintf(tw, "Generated at:\t%s\n", balance.GeneratedAt.Format(time.RFC3339))
+	})
+}
+
+func runBillingInvoices(env *cliEnv, args []string) error {
+	var output string
+	fs := newFlagSet("billing invoices", &output)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	invoices, err := fetchInvoices(context.Background(), env.client, nil)
+	if err != nil {
+		return err
+	}
+	if invoices == nil {
+		invoices = []godo.InvoiceListItem{}
+	}
+
+	return writeOutput(env.stdout, output, invoices, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "UUID\tPERIOD\tAMOUNT\tUPDATED")
+		for _, inv := range invoices {
+			fmt.Fprintf(tw, "%s\t%s\t$%s\t%s\n", inv.InvoiceUUID, inv.InvoicePeriod, inv.Amount, inv.UpdatedAt.Format("2006-01-02"))
+		}
+	})
+}
+
+func runBillingHistory(env *cliEnv, args []string) error {
+	var output string
+	fs := newFlagSet("billing history", &output)
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	history, err := fetchBillingHistory(context.Background(), env.client, nil)
+	if err != nil {
+		return err
+	}
+	entries := []godo.BillingHistoryEntry{}
+	if history != nil {
+		entries = append(entries, history.BillingHistory...)
+	}
+
+	return writeOutput(env.stdout, output, entries, func(tw *tabwriter.Writer) {
+		fmt.Fprintln(tw, "DATE\tTYPE\tDESCRIPTION\tAMOUNT")
+		for _, e := range entries {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t$%s\n", e.Date.Format("2006-01-02"), e.Type, e.Description, e.Amount)
+		}
+	})
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,836 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/digitalocean/godo"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+)
+
+// describeLabelWidth is the label column of the describe screen
+const describeLabelWidth = 18
+
+var (
+	yamlKeyStyle     = lipgloss.NewStyle().Foreground(primaryColor)
+	yamlStringStyle  = lipgloss.NewStyle().Foreground(successColor)
+	yamlLiteralStyle = lipgloss.NewStyle().Foreground(warningColor)
+	yamlLinePattern  = regexp.MustCompile(`^(\s*(?:- )*)([^\s:#"'][^:#]*?|"[^"]*"):(\s+(.*))?$`)
+	yamlNumberRegexp = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+)
+
+// clusterInspector is the describe and YAML screen for one cluster resource.
+// Its object is replaced by each watch snapshot, so the screen stays live.
+type clusterInspector struct {
+	key     string         // clusterResourceKey of the resource
+	kind    string         // e.g. "Deployment"
+	object  runtime.Object // From the informer's store, so read-only
+	deleted bool           // The resource has gone from the watch
+	yaml    bool           // Show the full object as YAML instead of the description
+	scroll  int
+
+	yamlLines  []string // Highlighted YAML of yamlObject, rendered once per version
+	yamlObject runtime.Object
+	yamlWidth  int
+
+	events       []corev1.Event
+	eventsErr    error
+	eventsLoaded bool
+}
+
+// clusterEventsLoadedMsg carries the events about the inspected resource
+type clusterEventsLoadedMsg struct {
+	inspector *clusterInspector
+	events    []corev1.Event
+	err       error
+}
+
+// openClusterInspector shows the describe (or YAML) screen for the row and loads its events
+func (m *model) openClusterInspector(r map[string]interface{}, showYAML bool) tea.Cmd {
+	obj, ok := r["object"].(runtime.Object)
+	if !ok {
+		return nil
+	}
+	ci := &clusterInspector{key: clusterResourceKey(r), object: obj, yaml: showYAML}
+	if gvks, _, err := scheme.Scheme.ObjectKinds(obj); err == nil && len(gvks) > 0 {
+		ci.kind = gvks[0].Kind
+	}
+	m.clusterInspector = ci
+	return loadClusterEvents(ci, m.kubeClients, m.client, m.selectedCluster)
+}
+
+// refresh picks up the resource's latest version from a watch snapshot
+func (ci *clusterInspector) refresh(resources []map[string]interface{}) {
+	for _, r := range resources {
+		if clusterResourceKey(r) == ci.key {
+			if obj, ok := r["object"].(runtime.Object); ok {
+				ci.object = obj
+				ci.deleted = false
+			}
+			return
+		}
+	}
+	ci.deleted = true
+}
+
+// loadClusterEvents lists the events about the inspected resource, oldest first
+func loadClusterEvents(ci *clusterInspector, kubeClients *kubeClientCache, client *godo.Client, cluster *godo.KubernetesCluster) tea.Cmd {
+	accessor, err := meta.Accessor(ci.object)
+	if err != nil {
+		return nil
+	}
+	selector := fields.Set{
+		"involvedObject.kind": ci.kind,
+		"involvedObject.name": accessor.GetName(),
+	}
+	namespace := accessor.GetNamespace()
+	if namespace != "" {
+		selector["involvedObject.namespace"] = namespace
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		kc, err := kubeClients.get(ctx, client, cluster)
+		if err != nil {
+			return clusterEventsLoadedMsg{inspector: ci, err: err}
+		}
+		list, err := kc.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+		if err != nil {
+			return clusterEventsLoadedMsg{inspector: ci, err: fmt.Errorf("events: %w", err)}
+		}
+		events := list.Items
+		sort.SliceStable(events, func(i, j int) bool {
+			return eventTime(events[i]).Before(eventTime(events[j]))
+		})
+		return clusterEventsLoadedMsg{inspector: ci, events: events}
+	}
+}
+
+// eventTime is when an event last happened
+func eventTime(e corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+func (m model) updateClusterInspector(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	ci := m.clusterInspector
+	switch msg.String() {
+	case "esc", "enter", "backspace":
+		m.clusterInspector = nil
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "y", "Y":
+		ci.yaml = true
+		ci.scroll = 0
+	case "d", "D":
+		ci.yaml = false
+		ci.scroll = 0
+	case "tab":
+		ci.yaml = !ci.yaml
+		ci.scroll = 0
+	case "e", "E":
+		ci.eventsLoaded = false
+		return m, loadClusterEvents(ci, m.kubeClients, m.client, m.selectedCluster)
+	case "up", "k":
+		ci.scroll = max(0, ci.scroll-1)
+	case "down", "j":
+		ci.scroll++
+	case "pgup", "ctrl+b":
+		ci.scroll = max(0, ci.scroll-m.clusterInspectorHeight())
+	case "pgdown", "ctrl+f":
+		ci.scroll += m.clusterInspectorHeight()
+	case "home", "g":
+		ci.scroll = 0
+	case "end", "G":
+		ci.scroll = 9999
+	}
+	return m, nil
+}
+
+// clusterInspectorHeight is how many content lines fit between the header and help lines
+func (m model) clusterInspectorHeight() int {
+	return max(5, m.height-getTopPadding()-3)
+}
+
+func (m model) renderClusterInspector() string {
+	ci := m.clusterInspector
+	width := max(40, m.width-1)
+
+	var lines []string
+	if ci.yaml {
+		if ci.yamlObject != ci.object || ci.yamlWidth != width {
+			ci.yamlLines = renderObjectYAML(ci.object, width)
+			ci.yamlObject, ci.yamlWidth = ci.object, width
+		}
+		lines = ci.yamlLines
+	} else {
+		lines = ci.describe(width)
+	}
+
+	height := m.clusterInspectorHeight()
+	maxScroll := max(0, len(lines)-height)
+	scroll := min(ci.scroll, maxScroll)
+	end := min(scroll+height, len(lines))
+
+	mode := "describe"
+	if ci.yaml {
+		mode = "yaml"
+	}
+	if ci.deleted {
+		mode += ", deleted"
+	}
+
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(headerStyle.Render(fmt.Sprintf("🔎 %s %s", ci.kind, ci.key)))
+	s.WriteString(helpStyle.Render(fmt.Sprintf("  (%s)", mode)))
+	s.WriteString("\n")
+	s.WriteString(strings.Join(lines[scroll:end], "\n"))
+	s.WriteString("\n")
+	help := "[d] Describe  [y] YAML  [e] Reload events  [↑/↓] Scroll  [g/G] Top/Bottom  [esc] Back"
+	if maxScroll > 0 {
+		help = fmt.Sprintf("%s  (%d/%d)", help, scroll+1, maxScroll+1)
+	}
+	s.WriteString(helpStyle.Render(help))
+	return s.String()
+}
+
+// renderObjectYAML renders the object the way kubectl get -o yaml does, highlighted
+func renderObjectYAML(obj runtime.Object, width int) []string {
+	obj = obj.DeepCopyObject()
+	// Objects from informers have no apiVersion and kind, so take them from the scheme
+	if gvks, _, err := scheme.Scheme.ObjectKinds(obj); err == nil && len(gvks) > 0 {
+		obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	}
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return []string{errorMessageStyle.Render(fmt.Sprintf("Error: %v", err))}
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = highlightYAMLLine(truncateString(line, width))
+	}
+	return lines
+}
+
+// highlightYAMLLine colors a line of YAML: keys, strings, and numbers, booleans and null
+func highlightYAMLLine(line string) string {
+	match := yamlLinePattern.FindStringSubmatch(line)
+	if match == nil {
+		// A list item, or a continuation of a multi-line string
+		trimmed := strings.TrimLeft(line, " ")
+		indent := line[:len(line)-len(trimmed)]
+		if strings.HasPrefix(trimmed, "- ") {
+			return indent + "- " + highlightYAMLValue(trimmed[2:])
+		}
+		return indent + yamlStringStyle.Render(trimmed)
+	}
+	s := match[1] + yamlKeyStyle.Render(match[2]) + ":"
+	if match[3] != "" {
+		s += match[3][:len(match[3])-len(match[4])] + highlightYAMLValue(match[4])
+	}
+	return s
+}
+
+func highlightYAMLValue(value string) string {
+	switch {
+	case value == "":
+		return ""
+	case value == "true" || value == "false" || value == "null" || value == "{}" || value == "[]" || value == "|" || value == "|-":
+		return yamlLiteralStyle.Render(value)
+	case yamlNumberRegexp.MatchString(value):
+		return yamlLiteralStyle.Render(value)
+	}
+	return yamlStringStyle.Render(value)
+}
+
+// describeWriter builds the lines of a kubectl describe style screen
+type describeWriter struct {
+	lines []string
+	width int
+}
+
+// section starts a titled block
+func (d *describeWriter) section(title string) {
+	if len(d.lines) > 0 {
+		d.lines = append(d.lines, "")
+	}
+	d.lines = append(d.lines, headerStyle.Render(title))
+}
+
+// field writes a label and value, indented by level. An empty label
+// continues the previous field's values.
+func (d *describeWriter) field(level int, label, value string) {
+	indent := strings.Repeat("  ", level)
+	labelText := strings.Repeat(" ", describeLabelWidth)
+	if label != "" {
+		labelText = fmt.Sprintf("%-*s", describeLabelWidth, label+":")
+	}
+	value = truncateString(value, max(10, d.width-len(indent)-len(labelText)))
+	d.lines = append(d.lines, indent+labelStyle.Render(labelText)+valueStyle.Render(value))
+}
+
+// text writes a line in the style, indented by level
+func (d *describeWriter) text(level int, style lipgloss.Style, text string) {
+	indent := strings.Repeat("  ", level)
+	d.lines = append(d.lines, indent+style.Render(truncateString(text, max(10, d.width-len(indent)))))
+}
+
+// keyValues writes a sorted map one pair per line under a label
+func (d *describeWriter) keyValues(level int, label string, values map[string]string) {
+	if len(values) == 0 {
+		d.field(level, label, "<none>")
+		return
+	}
+	for i, k := range sortedKeys(values) {
+		pair := k + "=" + strings.ReplaceAll(values[k], "\n", " ")
+		if i == 0 {
+			d.field(level, label, pair)
+		} else {
+			d.field(level, "", pair)
+		}
+	}
+}
+
+// describe renders the resource's description: metadata, kind-specific
+// details, conditions and events
+func (ci *clusterInspector) describe(width int) []string {
+	d := &describeWriter{width: width}
+	accessor, err := meta.Accessor(ci.object)
+	if err != nil {
+		return []string{errorMessageStyle.Render(fmt.Sprintf("Error: %v", err))}
+	}
+
+	d.section("Metadata")
+	d.field(0, "Name", accessor.GetName())
+	if ns := accessor.GetNamespace(); ns != "" {
+		d.field(0, "Namespace", ns)
+	}
+	created := accessor.GetCreationTimestamp()
+	d.field(0, "Created", fmt.Sprintf("%s (%s ago)", created.Format(time.RFC3339), describeAge(created.Time)))
+	d.keyValues(0, "Labels", accessor.GetLabels())
+	d.keyValues(0, "Annotations", accessor.GetAnnotations())
+	if owners := accessor.GetOwnerReferences(); len(owners) > 0 {
+		for i, owner := range owners {
+			ref := owner.Kind + "/" + owner.Name
+			if owner.Controller != nil && *owner.Controller {
+				ref += " (controller)"
+			}
+			label := ""
+			if i == 0 {
+				label = "Controlled By"
+			}
+			d.field(0, label, ref)
+		}
+	}
+
+	switch o := ci.object.(type) {
+	case *corev1.Pod:
+		describePod(d, o)
+	case *appsv1.Deployment:
+		describeDeployment(d, o)
+	case *appsv1.DaemonSet:
+		describeDaemonSet(d, o)
+	case *appsv1.StatefulSet:
+		describeStatefulSet(d, o)
+	case *corev1.Service:
+		describeService(d, o)
+	case *corev1.Node:
+		describeNode(d, o)
+	case *corev1.PersistentVolumeClaim:
+		describePVC(d, o)
+	case *corev1.ConfigMap:
+		d.section("Data")
+		if len(o.Data)+len(o.BinaryData) == 0 {
+			d.text(0, helpStyle, "<none>")
+		}
+		for _, k := range sortedKeys(o.Data) {
+			d.field(0, k, fmt.Sprintf("%d bytes", len(o.Data[k])))
+		}
+		for _, k := range sortedKeys(o.BinaryData) {
+			d.field(0, k, fmt.Sprintf("%d bytes (binary)", len(o.BinaryData[k])))
+		}
+	case *corev1.Secret:
+		// Like kubectl describe, show sizes rather than the values
+		d.section("Data")
+		d.field(0, "Type", string(o.Type))
+		for _, k := range sortedKeys(o.Data) {
+			d.field(0, k, fmt.Sprintf("%d bytes", len(o.Data[k])))
+		}
+	case *corev1.Namespace:
+		d.section("Status")
+		d.field(0, "Phase", string(o.Status.Phase))
+		describeConditions(d, namespaceConditions(o.Status.Conditions))
+	}
+
+	ci.describeEvents(d)
+	return d.lines
+}
+
+func (ci *clusterInspector) describeEvents(d *describeWriter) {
+	d.section("Events")
+	switch {
+	case ci.eventsErr != nil:
+		d.text(0, errorMessageStyle, fmt.Sprintf("Error: %v", ci.eventsErr))
+	case !ci.eventsLoaded:
+		d.text(0, helpStyle, "Loading...")
+	case len(ci.events) == 0:
+		d.text(0, helpStyle, "<none>")
+	default:
+		d.text(0, labelStyle, fmt.Sprintf("%-8s %-24s %-6s %-20s %s", "Type", "Reason", "Age", "From", "Message"))
+		for _, e := range ci.events {
+			age := describeAge(eventTime(e))
+			if e.Count > 1 {
+				age = fmt.Sprintf("%s (x%d)", age, e.Count)
+			}
+			from := e.Source.Component
+			if from == "" {
+				from = e.ReportingController
+			}
+			style := valueStyle
+			if e.Type == corev1.EventTypeWarning {
+				style = lipgloss.NewStyle().Foreground(warningColor)
+			}
+			d.text(0, style, fmt.Sprintf("%-8s %-24s %-6s %-20s %s", e.Type, e.Reason, age, from, strings.TrimSpace(e.Message)))
+		}
+	}
+}
+
+func describePod(d *describeWriter, pod *corev1.Pod) {
+	d.section("Status")
+	status := string(pod.Status.Phase)
+	if pod.DeletionTimestamp != nil {
+		status = "Terminating"
+	}
+	d.field(0, "Status", status)
+	if pod.Status.Reason != "" {
+		d.field(0, "Reason", pod.Status.Reason)
+	}
+	d.field(0, "Node", valueOr(pod.Spec.NodeName, "<none>"))
+	d.field(0, "IP", valueOr(pod.Status.PodIP, "<none>"))
+	d.field(0, "QoS Class", valueOr(string(pod.Status.QOSClass), "<none>"))
+	d.field(0, "Service Account", valueOr(pod.Spec.ServiceAccountName, "<none>"))
+	if pod.Status.StartTime != nil {
+		d.field(0, "Started", fmt.Sprintf("%s (%s ago)", pod.Status.StartTime.Format(time.RFC3339), describeAge(pod.Status.StartTime.Time)))
+	}
+
+	statuses := make(map[string]corev1.ContainerStatus)
+	for _, cs := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		statuses[cs.Name] = cs
+	}
+	if len(pod.Spec.InitContainers) > 0 {
+		d.section("Init Containers")
+		describeContainers(d, pod.Spec.InitContainers, statuses)
+	}
+	d.section("Containers")
+	describeContainers(d, pod.Spec.Containers, statuses)
+
+	if len(pod.Spec.Volumes) > 0 {
+		d.section("Volumes")
+		for _, v := range pod.Spec.Volumes {
+			d.field(0, v.Name, volumeSource(v))
+		}
+	}
+	describeConditions(d, podConditions(pod.Status.Conditions))
+}
+
+// describeContainers writes each container's spec, and its state if statuses has it
+func describeContainers(d *describeWriter, containers []corev1.Container, statuses map[string]corev1.ContainerStatus) {
+	for _, c := range containers {
+		d.text(0, valueStyle.Bold(true), c.Name+":")
+		d.field(1, "Image", c.Image)
+		if len(c.Ports) > 0 {
+			ports := make([]string, len(c.Ports))
+			for i, p := range c.Ports {
+				ports[i] = fmt.Sprintf("%d/%s", p.ContainerPort, p.Protocol)
+				if p.Name != "" {
+					ports[i] += " (" + p.Name + ")"
+				}
+			}
+			d.field(1, "Ports", strings.Join(ports, ", "))
+		}
+		if len(c.Command) > 0 {
+			d.field(1, "Command", strings.Join(c.Command, " "))
+		}
+		if len(c.Args) > 0 {
+			d.field(1, "Args", strings.Join(c.Args, " "))
+		}
+		if cs, ok := statuses[c.Name]; ok {
+			d.field(1, "State", containerState(cs.State))
+			if cs.LastTerminationState.Terminated != nil {
+				d.field(1, "Last State", containerState(cs.LastTerminationState))
+			}
+			d.field(1, "Ready", fmt.Sprintf("%t", cs.Ready))
+			d.field(1, "Restart Count", fmt.Sprintf("%d", cs.RestartCount))
+		}
+		if len(c.Resources.Requests) > 0 {
+			d.field(1, "Requests", resourceList(c.Resources.Requests))
+		}
+		if len(c.Resources.Limits) > 0 {
+			d.field(1, "Limits", resourceList(c.Resources.Limits))
+		}
+		if len(c.Env) > 0 || len(c.EnvFrom) > 0 {
+			for i, env := range c.Env {
+				value := env.Value
+				if env.ValueFrom != nil {
+					value = envValueSource(env.ValueFrom)
+				}
+				label := ""
+				if i == 0 {
+					label = "Environment"
+				}
+				d.field(1, label, env.Name+"="+value)
+			}
+			for _, from := range c.EnvFrom {
+				switch {
+				case from.ConfigMapRef != nil:
+					d.field(1, "Environment From", "configmap "+from.ConfigMapRef.Name)
+				case from.SecretRef != nil:
+					d.field(1, "Environment From", "secret "+from.SecretRef.Name)
+				}
+			}
+		}
+		for i, mount := range c.VolumeMounts {
+			value := mount.MountPath + " from " + mount.Name
+			if mount.ReadOnly {
+				value += " (ro)"
+			}
+			label := ""
+			if i == 0 {
+				label = "Mounts"
+			}
+			d.field(1, label, value)
+		}
+	}
+}
+
+func describeDeployment(d *describeWriter, dep *appsv1.Deployment) {
+	d.section("Rollout")
+	d.field(0, "Selector", labelSelector(dep.Spec.Selector))
+	d.field(0, "Replicas", fmt.Sprintf("%d desired | %d updated | %d total | %d available | %d unavailable",
+		replicasOrDefault(dep.Spec.Replicas), dep.Status.UpdatedReplicas, dep.Status.Replicas, dep.Status.AvailableReplicas, dep.Status.UnavailableReplicas))
+	d.field(0, "Strategy", string(dep.Spec.Strategy.Type))
+	if ru := dep.Spec.Strategy.RollingUpdate; ru != nil && ru.MaxUnavailable != nil && ru.MaxSurge != nil {
+		d.field(0, "Rolling Update", fmt.Sprintf("%s max unavailable, %s max surge", ru.MaxUnavailable.String(), ru.MaxSurge.String()))
+	}
+	describePodTemplate(d, dep.Spec.Template)
+	describeConditions(d, deploymentConditions(dep.Status.Conditions))
+}
+
+func describeDaemonSet(d *describeWriter, ds *appsv1.DaemonSet) {
+	d.section("Rollout")
+	d.field(0, "Selector", labelSelector(ds.Spec.Selector))
+	d.field(0, "Scheduled", fmt.Sprintf("%d desired | %d current | %d ready | %d up-to-date | %d available",
+		ds.Status.DesiredNumberScheduled, ds.Status.CurrentNumberScheduled, ds.Status.NumberReady, ds.Status.UpdatedNumberScheduled, ds.Status.NumberAvailable))
+	d.field(0, "Update Strategy", string(ds.Spec.UpdateStrategy.Type))
+	describePodTemplate(d, ds.Spec.Template)
+	describeConditions(d, daemonSetConditions(ds.Status.Conditions))
+}
+
+func describeStatefulSet(d *describeWriter, sts *appsv1.StatefulSet) {
+	d.section("Rollout")
+	d.field(0, "Selector", labelSelector(sts.Spec.Selector))
+	d.field(0, "Service Name", sts.Spec.ServiceName)
+	d.field(0, "Replicas", fmt.Sprintf("%d desired | %d total | %d ready | %d updated",
+		replicasOrDefault(sts.Spec.Replicas), sts.Status.Replicas, sts.Status.ReadyReplicas, sts.Status.UpdatedReplicas))
+	d.field(0, "Update Strategy", string(sts.Spec.UpdateStrategy.Type))
+	describePodTemplate(d, sts.Spec.Template)
+	describeConditions(d, statefulSetConditions(sts.Status.Conditions))
+}
+
+func describePodTemplate(d *describeWriter, template corev1.PodTemplateSpec) {
+	d.section("Pod Template")
+	d.keyValues(0, "Labels", template.Labels)
+	if template.Spec.ServiceAccountName != "" {
+		d.field(0, "Service Account", template.Spec.ServiceAccountName)
+	}
+	if len(template.Spec.InitContainers) > 0 {
+		d.text(0, labelStyle, "Init Containers:")
+		describeContainers(d, template.Spec.InitContainers, nil)
+	}
+	d.text(0, labelStyle, "Containers:")
+	describeContainers(d, template.Spec.Containers, nil)
+}
+
+func describeService(d *describeWriter, svc *corev1.Service) {
+	d.section("Service")
+	d.field(0, "Type", string(svc.Spec.Type))
+	d.keyValues(0, "Selector", svc.Spec.Selector)
+	d.field(0, "Cluster IP", valueOr(svc.Spec.ClusterIP, "<none>"))
+	var external []string
+	for _, ingress := range svc.Status.LoadBalancer.Ingress {
+		external = append(external, valueOr(ingress.IP, ingress.Hostname))
+	}
+	external = append(external, svc.Spec.ExternalIPs...)
+	d.field(0, "External IPs", valueOr(strings.Join(external, ", "), "<none>"))
+	for i, p := range svc.Spec.Ports {
+		value := fmt.Sprintf("%d/%s -> %s", p.Port, p.Protocol, p.TargetPort.String())
+		if p.NodePort != 0 {
+			value += fmt.Sprintf(" (node port %d)", p.NodePort)
+		}
+		if p.Name != "" {
+			value = p.Name + " " + value
+		}
+		label := ""
+		if i == 0 {
+			label = "Ports"
+		}
+		d.field(0, label, value)
+	}
+	d.field(0, "Session Affinity", string(svc.Spec.SessionAffinity))
+}
+
+func describeNode(d *describeWriter, node *corev1.Node) {
+	d.section("Node")
+	for i, addr := range node.Status.Addresses {
+		label := ""
+		if i == 0 {
+			label = "Addresses"
+		}
+		d.field(0, label, fmt.Sprintf("%s: %s", addr.Type, addr.Address))
+	}
+	d.field(0, "Capacity", resourceList(node.Status.Capacity))
+	d.field(0, "Allocatable", resourceList(node.Status.Allocatable))
+	info := node.Status.NodeInfo
+	d.field(0, "Kubelet", info.KubeletVersion)
+	d.field(0, "OS Image", info.OSImage)
+	d.field(0, "Kernel", info.KernelVersion)
+	d.field(0, "Runtime", info.ContainerRuntimeVersion)
+	d.field(0, "Unschedulable", fmt.Sprintf("%t", node.Spec.Unschedulable))
+	if len(node.Spec.Taints) == 0 {
+		d.field(0, "Taints", "<none>")
+	}
+	for i, taint := range node.Spec.Taints {
+		label := ""
+		if i == 0 {
+			label = "Taints"
+		}
+		d.field(0, label, taint.ToString())
+	}
+	describeConditions(d, nodeConditions(node.Status.Conditions))
+}
+
+func describePVC(d *describeWriter, pvc *corev1.PersistentVolumeClaim) {
+	d.section("Claim")
+	d.field(0, "Status", string(pvc.Status.Phase))
+	d.field(0, "Volume", valueOr(pvc.Spec.VolumeName, "<none>"))
+	if pvc.Spec.StorageClassName != nil {
+		d.field(0, "Storage Class", *pvc.Spec.StorageClassName)
+	}
+	d.field(0, "Capacity", valueOr(resourceList(pvc.Status.Capacity), "<none>"))
+	modes := make([]string, len(pvc.Spec.AccessModes))
+	for i, mode := range pvc.Spec.AccessModes {
+		modes[i] = string(mode)
+	}
+	d.field(0, "Access Modes", strings.Join(modes, ", "))
+	describeConditions(d, pvcConditions(pvc.Status.Conditions))
+}
+
+// describeCondition is the part of a status condition the describe screen shows;
+// every resource type has its own condition type
+type describeCondition struct {
+	kind, status, reason, message string
+	transition                    time.Time
+}
+
+func describeConditions(d *describeWriter, conditions []describeCondition) {
+	if len(conditions) == 0 {
+		return
+	}
+	d.section("Conditions")
+	d.text(0, labelStyle, fmt.Sprintf("%-28s %-7s %-26s %-6s %s", "Type", "Status", "Reason", "Age", "Message"))
+	for _, c := range conditions {
+		age := "-"
+		if !c.transition.IsZero() {
+			age = describeAge(c.transition)
+		}
+		d.text(0, valueStyle, fmt.Sprintf("%-28s %-7s %-26s %-6s %s", c.kind, c.status, c.reason, age, c.message))
+	}
+}
+
+func podConditions(in []corev1.PodCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+func deploymentConditions(in []appsv1.DeploymentCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+func daemonSetConditions(in []appsv1.DaemonSetCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+func statefulSetConditions(in []appsv1.StatefulSetCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+func nodeConditions(in []corev1.NodeCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+func pvcConditions(in []corev1.PersistentVolumeClaimCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+func namespaceConditions(in []corev1.NamespaceCondition) []describeCondition {
+	out := make([]describeCondition, len(in))
+	for i, c := range in {
+		out[i] = describeCondition{string(c.Type), string(c.Status), c.Reason, c.Message, c.LastTransitionTime.Time}
+	}
+	return out
+}
+
+// containerState describes a container state, e.g. "Terminated (OOMKilled, exit code 137)"
+func containerState(state corev1.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return fmt.Sprintf("Running since %s", state.Running.StartedAt.Format(time.RFC3339))
+	case state.Waiting != nil:
+		return "Waiting (" + state.Waiting.Reason + ")"
+	case state.Terminated != nil:
+		return fmt.Sprintf("Terminated (%s, exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+	}
+	return "Unknown"
+}
+
+// envValueSource describes where an environment variable's value comes from
+func envValueSource(from *corev1.EnvVarSource) string {
+	switch {
+	case from.SecretKeyRef != nil:
+		return fmt.Sprintf("<secret %s key %s>", from.SecretKeyRef.Name, from.SecretKeyRef.Key)
+	case from.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("<configmap %s key %s>", from.ConfigMapKeyRef.Name, from.ConfigMapKeyRef.Key)
+	case from.FieldRef != nil:
+		return fmt.Sprintf("<field %s>", from.FieldRef.FieldPath)
+	case from.ResourceFieldRef != nil:
+		return fmt.Sprintf("<resource %s>", from.ResourceFieldRef.Resource)
+	}
+	return "<unknown>"
+}
+
+// volumeSource describes what backs a pod volume
+func volumeSource(v corev1.Volume) string {
+	switch {
+	case v.PersistentVolumeClaim != nil:
+		return "pvc " + v.PersistentVolumeClaim.ClaimName
+	case v.ConfigMap != nil:
+		return "configmap " + v.ConfigMap.Name
+	case v.Secret != nil:
+		return "secret " + v.Secret.SecretName
+	case v.EmptyDir != nil:
+		return "emptyDir"
+	case v.HostPath != nil:
+		return "hostPath " + v.HostPath.Path
+	case v.Projected != nil:
+		return "projected"
+	}
+	return "other"
+}
+
+// resourceList formats resource quantities, e.g. "cpu=100m, memory=128Mi"
+func resourceList(list corev1.ResourceList) string {
+	names := make([]string, 0, len(list))
+	for name := range list {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		quantity := list[corev1.ResourceName(name)]
+		parts[i] = name + "=" + quantity.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+func labelSelector(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return "<none>"
+	}
+	return valueOr(metav1.FormatLabelSelector(selector), "<none>")
+}
+
+// replicasOrDefault returns the replica count, which the API defaults to 1 when unset
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+func valueOr(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// describeAge formats how long ago t was, like kubectl: "45s", "12m", "5h" or "3d"
+func describeAge(t time.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// clusterWatchDebounce batches bursts of changes (e.g. a rollout) into one table update
+	clusterWatchDebounce = 250 * time.Millisecond
+	// clusterChangeHighlight is how long added and modified rows stay marked
+	clusterChangeHighlight = 5 * time.Second
+)
+
+func init() {
+	// client-go logs reflector errors to stderr, which would draw over the TUI.
+	// Watch errors are reported through the error bar instead.
+	klog.LogToStderr(false)
+	klog.SetOutput(io.Discard)
+}
+
+// clusterWatch streams one resource type of a cluster into the model. It is
+// shared by pointer between model copies, and stopped when the user leaves
+// the cluster, switches resource type or namespace.
+type clusterWatch struct {
+	stop     chan struct{}
+	updates  chan tea.Msg
+	stopOnce sync.Once
+}
+
+// clusterResourcesLoadedMsg is a full snapshot of the watched resources
+type clusterResourcesLoadedMsg struct {
+	watch        *clusterWatch
+	resourceType string
+	resources    []map[string]interface{}
+	changed      map[string]string // Keys (see clusterResourceKey) changed since the last snapshot: "+" added, "~" modified, "-" deleted
+}
+
+// clusterWatchErrMsg reports a list or watch failure; the watch keeps retrying
+type clusterWatchErrMsg struct {
+	watch *clusterWatch
+	err   error
+}
+
+// clusterHighlightExpiredMsg prompts a redraw once change markers may have expired
+type clusterHighlightExpiredMsg struct{}
+
+// clusterResourceChange is a recent change to a row, for highlighting
+type clusterResourceChange struct {
+	kind string // "+" added or "~" modified
+	at   time.Time
+}
+
+func (w *clusterWatch) close() {
+	w.stopOnce.Do(func() { close(w.stop) })
+}
+
+// send delivers a message unless the watch has been stopped
+func (w *clusterWatch) send(msg tea.Msg) {
+	select {
+	case w.updates <- msg:
+	case <-w.stop:
+	}
+}
+
+// waitForClusterWatch waits for the next message from the watch. Handlers
+// re-issue it after each message, like waitForLoadProgress.
+func waitForClusterWatch(w *clusterWatch) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case msg := <-w.updates:
+			return msg
+		case <-w.stop:
+			return nil
+		}
+	}
+}
+
+// watchClusterResources replaces any running watch with one for the current
+// cluster, resource type and namespace
+func (m *model) watchClusterResources() tea.Cmd {
+	m.stopClusterWatch()
+	if m.selectedCluster == nil {
+		return nil
+	}
+	w := &clusterWatch{stop: make(chan struct{}), updates: make(chan tea.Msg)}
+	m.clusterWatch = w
+	m.clusterChanges = make(map[string]clusterResourceChange)
+	m.loading = true
+	go runClusterWatch(w, m.kubeClients, m.client, m.selectedCluster, m.clusterResourceType, m.selectedNamespace)
+	return tea.Batch(waitForClusterWatch(w), m.spinner.Tick)
+}
+
+// stopClusterWatch tears down the running watch, if any, and the describe screen it feeds
+func (m *model) stopClusterWatch() {
+	if m.clusterWatch != nil {
+		m.clusterWatch.close()
+		m.clusterWatch = nil
+	}
+	m.clusterInspector = nil
+}
+
+// runClusterWatch syncs an informer for the resource type and sends a
+// snapshot whenever it changes, until the watch is stopped
+func runClusterWatch(w *clusterWatch, kubeClients *kubeClientCache, client *godo.Client, cluster *godo.KubernetesCluster, resourceType, namespace string) {
+	kc, err := kubeClients.get(context.Background(), client, cluster)
+	if err != nil {
+		w.send(clusterWatchErrMsg{watch: w, err: err})
+		return
+	}
+
+	// Empty string means all namespaces
+	factory := informers.NewSharedInformerFactoryWithOptions(kc.clientset, 0, informers.WithNamespace(namespace))
+	informer := clusterResourceInformer(factory, resourceType)
+	if informer == nil {
+		w.send(clusterResourcesLoadedMsg{watch: w, resourceType: resourceType})
+		return
+	}
+
+	var mu sync.Mutex
+	pending := make(map[string]string)
+	notify := make(chan struct{}, 1)
+	record := func(obj interface{}, kind string) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return
+		}
+		mu.Lock()
+		// A row added and then modified before the next snapshot is still new
+		if pending[key] != "+" || kind == "-" {
+			pending[key] = kind
+		}
+		mu.Unlock()
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+	informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				record(obj, "+")
+			}
+		},
+		UpdateFunc: func(_, obj interface{}) { record(obj, "~") },
+		DeleteFunc: func(obj interface{}) { record(obj, "-") },
+	})
+	informer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		w.send(clusterWatchErrMsg{watch: w, err: fmt.Errorf("watching %s: %w", resourceType, err)})
+	})
+
+	snapshot := func(changed map[string]string) clusterResourcesLoadedMsg {
+		items := informer.GetStore().List()
+		resources := make([]map[string]interface{}, 0, len(items))
+		for _, obj := range items {
+			if r := clusterResourceRow(obj); r != nil {
+				// Keep the typed object for the describe and YAML screen
+				r["object"] = obj
+				resources = append(resources, r)
+			}
+		}
+		sort.Slice(resources, func(i, j int) bool {
+			return clusterResourceKey(resources[i]) < clusterResourceKey(resources[j])
+		})
+		return clusterResourcesLoadedMsg{watch: w, resourceType: resourceType, resources: resources, changed: changed}
+	}
+
+	factory.Start(w.stop)
+	defer factory.Shutdown()
+	if !cache.WaitForCacheSync(w.stop, informer.HasSynced) {
+		return // Stopped before the first list completed
+	}
+	w.send(snapshot(nil))
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-notify:
+		}
+		select {
+		case <-w.stop:
+			return
+		case <-time.After(clusterWatchDebounce):
+		}
+		mu.Lock()
+		changed := pending
+		pending = make(map[string]string)
+		mu.Unlock()
+		w.send(snapshot(changed))
+	}
+}
+
+// clusterResourceInformer returns the informer for a resource type, or nil if it isn't supported
+func clusterResourceInformer(factory informers.SharedInformerFactory, resourceType string) cache.SharedIndexInformer {
+	switch resourceType {
+	case "deployments":
+		return factory.Apps().V1().Deployments().Informer()
+	case "daemonsets":
+		return factory.Apps().V1().DaemonSets().Informer()
+	case "statefulsets":
+		return factory.Apps().V1().StatefulSets().Informer()
+	case "pvc":
+		return factory.Core().V1().PersistentVolumeClaims().Informer()
+	case "configmaps":
+		return factory.Core().V1().ConfigMaps().Informer()
+	case "secrets":
+		return factory.Core().V1().Secrets().Informer()
+	case "pods":
+		return factory.Core().V1().Pods().Informer()
+	case "services":
+		return factory.Core().V1().Services().Informer()
+	case "nodes":
+		return factory.Core().V1().Nodes().Informer()
+	case "namespaces":
+		return factory.Core().V1().Namespaces().Informer()
+	}
+	return nil
+}
+
+// clusterResourceKey identifies a row the way informers key objects: "namespace/name", or "name" if cluster-scoped
+func clusterResourceKey(r map[string]interface{}) string {
+	name := getMapValue(r, "name", "")
+	if ns := getMapValue(r, "namespace", ""); ns != "" {
+		return ns + "/" + name
+	}
+	return name
+}
+
+// clusterResourceAge formats how long ago an object was created, e.g. "5h" or "12d"
+func clusterResourceAge(created metav1.Time) string {
+	if created.IsZero() {
+		return "N/A"
+	}
+	duration := time.Since(created.Time)
+	if duration.Hours() < 24 {
+		return fmt.Sprintf("%.0fh", duration.Hours())
+	}
+	return fmt.Sprintf("%.0fd", duration.Hours()/24)
+}
+
+// clusterResourceRow turns a Kubernetes object into the row fields used by the cluster resources table
+func clusterResourceRow(obj interface{}) map[string]interface{} {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return map[string]interface{}{
+			"name":      o.Name,
+			"namespace": o.Namespace,
+			"ready":     fmt.Sprintf("%d/%d", o.Status.ReadyReplicas, o.Status.Replicas),
+			"upToDate":  fmt.Sprintf("%d", o.Status.UpdatedReplicas),
+			"available": fmt.Sprintf("%d", o.Status.AvailableReplicas),
+			"age":       clusterResourceAge(o.CreationTimestamp),
+		}
+	case *appsv1.DaemonSet:
+		return map[string]interface{}{
+			"name":      o.Name,
+			"namespace": o.Namespace,
+			"ready":     fmt.Sprintf("%d/%d", o.Status.NumberReady, o.Status.DesiredNumberScheduled),
+			"current":   fmt.Sprintf("%d", o.Status.CurrentNumberScheduled),
+			"age":       clusterResourceAge(o.CreationTimestamp),
+		}
+	case *appsv1.StatefulSet:
+		replicas := int32(1) // The API default when unset
+		if o.Spec.Replicas != nil {
+			replicas = *o.Spec.Replicas
+		}
+		return map[string]interface{}{
+			"name":      o.Name,
+			"namespace": o.Namespace,
+			"ready":     fmt.Sprintf("%d/%d", o.Status.ReadyReplicas, replicas),
+			"age":       clusterResourceAge(o.CreationTimestamp),
+		}
+	case *corev1.PersistentVolumeClaim:
+		capacity := "N/A"
+		if storage, ok := o.Status.Capacity[corev1.ResourceStorage]; ok {
+			capacity = storage.String()
+		}
+		return map[string]interface{}{
+			"name":      o.Name,
+			"namespace": o.Namespace,
+			"status":    string(o.Status.Phase),
+			"capacity":  capacity,
+			"age":       clusterResourceAge(o.CreationTimestamp),
+		}
+	case *corev1.ConfigMap:
+		return map[string]interface{}{
+			"name":      o.Name,
+			"namespace": o.Namespace,
+			"data":      fmt.Sprintf("%d", len(o.Data)),
+			"age":       clusterResourceAge(o.CreationTimestamp),
+		}
+	case *corev1.Secret:
+		return map[string]interface{}{
+			"name":      o.Name,
+			"namespace": o.Namespace,
+			"type":      string(o.Type),
+			"data":      fmt.Sprintf("%d", len(o.Data)),
+			"age":       clusterResourceAge(o.CreationTimestamp),
+		}
+	case *corev1.Pod:
+		ready := 0
+		var restarts int32
+		for _, cs := range o.Status.ContainerStatuses {
+			if cs.Ready {
+				ready++
+			}
+			restarts += cs.RestartCount
+		}
+		status := string(o.Status.Phase)
+		if o.DeletionTimestamp != nil {
+			status = "Terminating"
+		}
+		containers := make([]string, len(o.Spec.Containers))
+		for i, c := range o.Spec.Containers {
+			containers[i] = c.Name
+		}
+		return map[string]interface{}{
+			"name":       o.Name,
+			"namespace":  o.Namespace,
+			"ready":      fmt.Sprintf("%d/%d", ready, len(o.Spec.Containers)),
+			"status":     status,
+			"restarts":   fmt.Sprintf("%d", restarts),
+			"age":        clusterResourceAge(o.CreationTimestamp),
+			"containers": containers,
+		}
+	case *corev1.Service:
+		externalIP := "<none>"
+		if len(o.Status.LoadBalancer.Ingress) > 0 {
+			externalIP = o.Status.LoadBalancer.Ingress[0].IP
+		}
+		return map[string]interface{}{
+			"name":       o.Name,
+			"namespace":  o.Namespace,
+			"type":       string(o.Spec.Type),
+			"clusterIP":  o.Spec.ClusterIP,
+			"externalIP": externalIP,
+			"age":        clusterResourceAge(o.CreationTimestamp),
+		}
+	case *corev1.Node:
+		status := "NotReady"
+		for _, condition := range o.Status.Conditions {
+			if condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue {
+				status = "Ready"
+				break
+			}
+		}
+		roles := "<none>"
+		if len(o.Labels["node-role.kubernetes.io/master"]) > 0 {
+			roles = "master"
+		}
+		return map[string]interface{}{
+			"name":    o.Name,
+			"status":  status,
+			"roles":   roles,
+			"age":     clusterResourceAge(o.CreationTimestamp),
+			"version": o.Status.NodeInfo.KubeletVersion,
+		}
+	case *corev1.Namespace:
+		status := "Active"
+		if o.Status.Phase != "" {
+			status = string(o.Status.Phase)
+		}
+		return map[string]interface{}{
+			"name":   o.Name,
+			"status": status,
+			"age":    clusterResourceAge(o.CreationTimestamp),
+		}
+	}
+	return nil
+}
+
+// applyClusterResourceChanges records the snapshot's changes for
+// highlighting, and returns a command to redraw once they expire
+func (m *model) applyClusterResourceChanges(changed map[string]string) tea.Cmd {
+	if len(changed) == 0 {
+		return nil
+	}
+	if m.clusterChanges == nil {
+		m.clusterChanges = make(map[string]clusterResourceChange)
+	}
+	now := time.Now()
+	for key, kind := range changed {
+		if kind == "-" {
+			delete(m.clusterChanges, key)
+			continue
+		}
+		m.clusterChanges[key] = clusterResourceChange{kind: kind, at: now}
+	}
+	return tea.Tick(clusterChangeHighlight, func(time.Time) tea.Msg {
+		return clusterHighlightExpiredMsg{}
+	})
+}
+
+// pruneClusterResourceChanges drops change markers older than clusterChangeHighlight
+func (m *model) pruneClusterResourceChanges() {
+	for key, change := range m.clusterChanges {
+		if time.Since(change.at) >= clusterChangeHighlight {
+			delete(m.clusterChanges, key)
+		}
+	}
+}
+
+// clusterResourceMarker returns the row's change marker ("+ " added, "~ " modified),
+// padding unchanged rows while any marker is shown so names stay aligned
+func (m *model) clusterResourceMarker(r map[string]interface{}) string {
+	if len(m.clusterChanges) == 0 || m.clusterResourceType == "namespaces" {
+		return ""
+	}
+	if change, ok := m.clusterChanges[clusterResourceKey(r)]; ok {
+		return change.kind + " "
+	}
+	return "  "
+}
+
+// selectedClusterResource returns the row under the cursor, or nil
+func (m *model) selectedClusterResource() map[string]interface{} {
+	i := m.table.Cursor()
+	if m.clusterResourceType == "namespaces" {
+		i-- // The "all" row comes first
+	}
+	if i < 0 || i >= len(m.clusterResources) {
+		return nil
+	}
+	return m.clusterResources[i]
+}
+
+// selectClusterResource moves the cursor to the row with the key, if it still exists
+func (m *model) selectClusterResource(key string) {
+	for i, r := range m.clusterResources {
+		if clusterResourceKey(r) == key {
+			if m.clusterResourceType == "namespaces" {
+				i++
+			}
+			m.table.SetCursor(i)
+			return
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFileName is the name of the config file looked up in the working
+// directory and in $XDG_CONFIG_HOME/dogoctl/
+const configFileName = "config.yaml"
+
+// minRefreshInterval prevents auto-refresh from hammering the DigitalOcean API
+const minRefreshInterval = 10 * time.Second
+
+// Config holds the droplet template and app settings loaded from config.yaml
+type Config struct {
+	// Droplet template - used to pre-fill the create form
+	DropletName string   `yaml:"droplet_name"`
+	Region      string   `yaml:"region"`
+	Size        string   `yaml:"size"`
+	ImageSlug   string   `yaml:"image_slug"`
+	Tags        []string `yaml:"tags"`
+	SSHKeys     []string `yaml:"ssh_keys"` // Account SSH keys to select by default, by name, ID or fingerprint
+
+	// Named DigitalOcean contexts (accounts/teams)
+	CurrentContext string              `yaml:"current_context"`
+	Contexts       map[string]*Context `yaml:"contexts"`
+
+	// Spaces (S3-compatible object storage) access keys
+	Spaces *SpacesConfig `yaml:"spaces"`
+
+	// App settings
+	Settings Settings `yaml:"settings"`
+}
+
+// SpacesConfig holds the access keys and endpoints for the Spaces view.
+// Spaces keys are separate from the API token and are created under API > Spaces Keys.
+type SpacesConfig struct {
+	AccessKey    string   `yaml:"access_key"`     // Raw access key
+	AccessKeyEnv string   `yaml:"access_key_env"` // Environment variable holding the access key
+	SecretKey    string   `yaml:"secret_key"`     // Raw secret key (prefer secret_key_env)
+	SecretKeyEnv string   `yaml:"secret_key_env"` // Environment variable holding the secret key
+	Regions      []string `yaml:"regions"`        // Regions to list buckets in (default: all Spaces regions)
+	Endpoint     string   `yaml:"endpoint"`       // Any S3-compatible endpoint, e.g. http://localhost:9000 for MinIO
+}
+
+// Settings holds application behaviour settings
+type Settings struct {
+	DefaultView     string `yaml:"default_view"`     // "droplets", "clusters" or "billing"
+	TopPadding      *int   `yaml:"top_padding"`      // Rows of top padding (DOGOCTL_TOP_PADDING still wins)
+	RefreshInterval string `yaml:"refresh_interval"` // Auto-refresh interval, e.g. "30s" (empty = disabled)
+	ExecCommand     string `yaml:"exec_command"`     // Command pod shells run, e.g. "/bin/bash" (default /bin/sh)
+	PublicIPLookup  *bool  `yaml:"public_ip_lookup"` // Look up the public IP for the droplet SSH check (default true)
+}
+
+// configTopPadding is the top padding from the config file, consulted by getTopPadding
+var configTopPadding *int
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// configDir returns the dogoctl directory under $XDG_CONFIG_HOME (or ~/.config)
+func configDir() string {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, "dogoctl")
+}
+
+// findConfigFile returns the first config file found, checking the working
+// directory before the XDG config directory. Returns "" if none exists.
+func findConfigFile() string {
+	candidates := []string{configFileName}
+	if dir := configDir(); dir != "" {
+		candidates = append(candidates, filepath.Join(dir, configFileName))
+	}
+	for _, path := range candidates {
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+	}
+	return ""
+}
+
+// loadConfig finds, parses and validates the config file.
+// A missing config file is not an error - an empty config is returned.
+// On parse or validation errors the returned config is still usable (empty).
+func loadConfig() (*Config, error) {
+	path := findConfigFile()
+	if path == "" {
+		return &Config{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return &Config{}, fmt.Errorf("failed to read config %s: %v", path, err)
+	}
+
+	cfg, err := parseConfig(data)
+	if err != nil {
+		return &Config{}, fmt.Errorf("invalid config %s: %v", path, err)
+	}
+	return cfg, nil
+}
+
+// parseConfig parses and validates config YAML
+func parseConfig(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) validate() error {
+	if len(c.DropletName) > 255 {
+		return fmt.Errorf("droplet_name is too long (max 255 characters)")
+	}
+	for field, value := range map[string]string{
+		"region":     c.Region,
+		"size":       c.Size,
+		"image_slug": c.ImageSlug,
+	} {
+		if value != "" && !slugPattern.MatchString(value) {
+			return fmt.Errorf("%s %q is not a valid slug", field, value)
+		}
+	}
+	for _, tag := range c.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("tags must not contain empty values")
+		}
+		if strings.Contains(tag, ",") {
+			return fmt.Errorf("tag %q must not contain commas", tag)
+		}
+	}
+	for _, key := range c.SSHKeys {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("ssh_keys must not contain empty values")
+		}
+	}
+
+	for name, ctx := range c.Contexts {
+		if ctx == nil {
+			return fmt.Errorf("contexts.%s must not be empty", name)
+		}
+		if ctx.DefaultRegion != "" && !slugPattern.MatchString(ctx.DefaultRegion) {
+			return fmt.Errorf("contexts.%s.default_region %q is not a valid slug", name, ctx.DefaultRegion)
+		}
+	}
+	if c.Spaces != nil {
+		if err := c.Spaces.validate("spaces"); err != nil {
+			return err
+		}
+	}
+	for name, ctx := range c.Contexts {
+		if ctx.Spaces != nil {
+			if err := ctx.Spaces.validate(fmt.Sprintf("contexts.%s.spaces", name)); err != nil {
+				return err
+			}
+		}
+	}
+	if c.CurrentContext != "" && c.Contexts[c.CurrentContext] == nil {
+		return fmt.Errorf("current_context %q is not defined in contexts", c.CurrentContext)
+	}
+
+	switch c.Settings.DefaultView {
+	case "", viewDroplets, viewClusters, viewBilling:
+	default:
+		return fmt.Errorf("settings.default_view %q must be one of: droplets, clusters, billing", c.Settings.DefaultView)
+	}
+	if c.Settings.TopPadding != nil && *c.Settings.TopPadding < 0 {
+		return fmt.Errorf("settings.top_padding must not be negative")
+	}
+	if c.Settings.RefreshInterval != "" {
+		interval, err := time.ParseDuration(c.Settings.RefreshInterval)
+		if err != nil {
+			return fmt.Errorf("settings.refresh_interval: %v", err)
+		}
+		if interval < minRefreshInterval {
+			return fmt.Errorf("settings.refresh_interval must be at least %s", minRefreshInterval)
+		}
+	}
+	return nil
+}
+
+func (s *SpacesConfig) validate(field string) error {
+	for _, region := range s.Regions {
+		if !slugPattern.MatchString(region) {
+			return fmt.Errorf("%s.regions: %q is not a valid slug", field, region)
+		}
+	}
+	if s.Endpoint != "" {
+		u, err := url.Parse(s.Endpoint)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+			return fmt.Errorf("%s.endpoint %q must be an http(s) URL", field, s.Endpoint)
+		}
+	}
+	return nil
+}
+
+// keys returns the access and secret keys, reading them from the environment when configured
+func (s *SpacesConfig) keys() (accessKey, secretKey string, err error) {
+	accessKey, secretKey = s.AccessKey, s.SecretKey
+	if s.AccessKeyEnv != "" {
+		accessKey = strings.TrimSpace(os.Getenv(s.AccessKeyEnv))
+	}
+	if s.SecretKeyEnv != "" {
+		secretKey = strings.TrimSpace(os.Getenv(s.SecretKeyEnv))
+	}
+	if accessKey == "" || secretKey == "" {
+		return "", "", fmt.Errorf("spaces access_key and secret_key (or access_key_env and secret_key_env) must be set in config.yaml")
+	}
+	return accessKey, secretKey, nil
+}
+
+// refreshInterval returns the auto-refresh interval, or 0 if disabled
+func (c *Config) refreshInterval() time.Duration {
+	if c == nil || c.Settings.RefreshInterval == "" {
+		return 0
+	}
+	interval, err := time.ParseDuration(c.Settings.RefreshInterval)
+	if err != nil {
+		return 0
+	}
+	return interval
+}
+
+// execCommand returns the command pod shells run
+func (c *Config) execCommand() string {
+	if c == nil || strings.TrimSpace(c.Settings.ExecCommand) == "" {
+		return defaultExecCommand
+	}
+	return c.Settings.ExecCommand
+}
+
+// publicIPLookup reports whether droplet details may ask api.ipify.org for
+// the user's public IP
+func (c *Config) publicIPLookup() bool {
+	return c == nil || c.Settings.PublicIPLookup == nil || *c.Settings.PublicIPLookup
+}
+
+// defaultView returns the view to start in
+func (c *Config) defaultView() string {
+	if c == nil || c.Settings.DefaultView == "" {
+		return viewDroplets
+	}
+	return c.Settings.DefaultView
+}
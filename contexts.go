@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+	"golang.org/x/oauth2"
+)
+
+// defaultContextName is used when no named context is configured or selected
+const defaultContextName = "default"
+
+// Context is a named DigitalOcean account/team configuration
+type Context struct {
+	Token          string `yaml:"token"`           // Raw API token (prefer one of the sources below)
+	TokenEnv       string `yaml:"token_env"`       // Environment variable holding the token
+	TokenFile      string `yaml:"token_file"`      // File holding the token (must be chmod 600)
+	TokenCommand   string `yaml:"token_command"`   // Credential helper command that prints the token
+	DoctlContext   string `yaml:"doctl_context"`   // doctl auth context to take the token from
+	DefaultRegion  string `yaml:"default_region"`  // Region pre-selected in the create form
+	DefaultProject string `yaml:"default_project"` // Project new resources belong to
+
+	Spaces *SpacesConfig `yaml:"spaces"` // Spaces keys for this account (overrides the top-level spaces)
+}
+
+// contextSwitchedMsg is sent when a :ctx switch has built a new client
+type contextSwitchedMsg struct {
+	name        string
+	context     *Context
+	client      *godo.Client
+	tokenSource string
+}
+
+// clientMsg is a message from a command that used the API client. Update
+// drops it if a :ctx switch has replaced that client since, so loads still in
+// flight can't fill the new context with the old account's resources.
+type clientMsg struct {
+	client *godo.Client
+	msg    tea.Msg
+}
+
+// withClient tags the message of a command that uses the client
+func withClient(client *godo.Client, cmd tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		return clientMsg{client: client, msg: cmd()}
+	}
+}
+
+// contextNames returns the configured context names, sorted
+func (c *Config) contextNames() []string {
+	if c == nil {
+		return nil
+	}
+	names := make([]string, 0, len(c.Contexts))
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveContext returns the context to use for the given name.
+// An empty name selects current_context, falling back to an implicit
+// default context that uses the default token provider chain.
+func (c *Config) resolveContext(name string) (string, *Context, error) {
+	var contexts map[string]*Context
+	if c != nil {
+		contexts = c.Contexts
+		if name == "" {
+			name = c.CurrentContext
+		}
+	}
+	if name == "" || (name == defaultContextName && contexts[defaultContextName] == nil) {
+		return defaultContextName, &Context{}, nil
+	}
+	if contexts[name] == nil {
+		available := "none configured"
+		if names := c.contextNames(); len(names) > 0 {
+			available = strings.Join(names, ", ")
+		}
+		return "", nil, fmt.Errorf("unknown context %q (available: %s)", name, available)
+	}
+	return name, contexts[name], nil
+}
+
+// newGodoClient builds a DigitalOcean API client for the token
+func newGodoClient(token string) *godo.Client {
+	tokenSource := &TokenSource{AccessToken: token}
+	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
+	return godo.NewClient(oauthClient)
+}
+
+// switchContext resolves the named context and builds a new client for it
+func switchContext(cfg *Config, name string) tea.Cmd {
+	return func() tea.Msg {
+		resolvedName, ctx, err := cfg.resolveContext(name)
+		if err != nil {
+			return errMsg(err)
+		}
+		token, source, err := ctx.token()
+		if err != nil {
+			return errMsg(fmt.Errorf("context %s: %v", resolvedName, err))
+		}
+		return contextSwitchedMsg{
+			name:        resolvedName,
+			context:     ctx,
+			client:      newGodoClient(token),
+			tokenSource: source,
+		}
+	}
+}
+
+// contextLabel returns the active context name with the account email, if known
+func (m model) contextLabel() string {
+	label := m.contextName
+	if label == "" {
+		label = defaultContextName
+	}
+	if m.account != nil && m.account.Email != "" {
+		label = fmt.Sprintf("%s (%s)", label, m.account.Email)
+	}
+	if m.activeProject != nil {
+		label = fmt.Sprintf("%s [project: %s]", label, m.activeProject.Name)
+	}
+	return label
+}
+
+// defaultRegion returns the active context's default region, falling back to the template region
+func (m *model) defaultRegion() string {
+	if m.activeContext != nil && m.activeContext.DefaultRegion != "" {
+		return m.activeContext.DefaultRegion
+	}
+	if m.config != nil {
+		return m.config.Region
+	}
+	return ""
+}
+
+// spacesConfig returns the active context's Spaces settings, falling back to the top-level ones
+func (m *model) spacesConfig() *SpacesConfig {
+	if m.activeContext != nil && m.activeContext.Spaces != nil {
+		return m.activeContext.Spaces
+	}
+	if m.config != nil {
+		return m.config.Spaces
+	}
+	return nil
+}
@@ -0,0 +1,433 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/digitalocean/godo"
+)
+
+type databasesLoadedMsg []godo.Database
+
+// databaseDetails holds what the details screen loads on top of the database list.
+// Each part fails on its own, e.g. connection pools only exist for PostgreSQL.
+type databaseDetails struct {
+	users    []godo.DatabaseUser
+	usersErr error
+	dbs      []godo.DatabaseDB
+	dbsErr   error
+	pools    []godo.DatabasePool
+	poolsErr error
+	rules    []godo.DatabaseFirewallRule
+	rulesErr error
+}
+
+// databaseDetailsMsg carries the details of a database cluster
+type databaseDetailsMsg struct {
+	id      string
+	details *databaseDetails
+}
+
+// maskedPassword replaces passwords until they are toggled with p
+const maskedPassword = "••••••••"
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewDatabases,
+		aliases: []string{"database", "db", "dbs"},
+		title:   "Databases",
+		load: func(m *model) tea.Cmd {
+			return loadDatabases(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.projectDatabases())
+		},
+		updateTable:   (*model).updateDatabaseTable,
+		handleKey:     (*model).handleDatabaseKey,
+		renderDetails: model.renderDatabaseDetails,
+		detailsKey:    (*model).handleDatabaseDetailsKey,
+	})
+}
+
+func loadDatabases(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		databases, err := fetchDatabases(context.Background(), client, reportProgress(progressChan, client, "databases"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return databasesLoadedMsg(databases)
+	})
+}
+
+// loadDatabaseDetails loads the users, databases, connection pools and trusted sources of a cluster
+func loadDatabaseDetails(client *godo.Client, db godo.Database) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		ctx := context.Background()
+		details := &databaseDetails{}
+		details.users, details.usersErr = listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DatabaseUser, *godo.Response, error) {
+			return client.Databases.ListUsers(ctx, db.ID, opt)
+		}, nil)
+		if databaseHasDBs(db.EngineSlug) {
+			details.dbs, details.dbsErr = listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DatabaseDB, *godo.Response, error) {
+				return client.Databases.ListDBs(ctx, db.ID, opt)
+			}, nil)
+		}
+		if db.EngineSlug == "pg" {
+			details.pools, details.poolsErr = listAll(ctx, defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]godo.DatabasePool, *godo.Response, error) {
+				return client.Databases.ListPools(ctx, db.ID, opt)
+			}, nil)
+		}
+		details.rules, _, details.rulesErr = client.Databases.GetFirewallRules(ctx, db.ID)
+		return databaseDetailsMsg{id: db.ID, details: details}
+	})
+}
+
+// databaseHasDBs reports whether the engine has logical databases
+func databaseHasDBs(engine string) bool {
+	return engine == "pg" || engine == "mysql" || engine == "mongodb"
+}
+
+// databaseEngineName returns a display name for an engine slug, e.g. "pg" -> "PostgreSQL"
+func databaseEngineName(engine string) string {
+	switch engine {
+	case "pg":
+		return "PostgreSQL"
+	case "mysql":
+		return "MySQL"
+	case "redis":
+		return "Redis"
+	case "valkey":
+		return "Valkey"
+	case "mongodb":
+		return "MongoDB"
+	case "kafka":
+		return "Kafka"
+	case "opensearch":
+		return "OpenSearch"
+	}
+	return engine
+}
+
+// selectedDatabase returns the database under the cursor
+func (m *model) selectedDatabase() (godo.Database, bool) {
+	databases := m.projectDatabases()
+	i := m.selectedIndex(len(databases))
+	if i < 0 {
+		return godo.Database{}, false
+	}
+	return databases[i], true
+}
+
+func (m *model) updateDatabaseTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.22, 15},
+		{"ENGINE", 0.12, 8},
+		{"VERSION", 0.08, 7},
+		{"SIZE", 0.20, 12},
+		{"NODES", 0.07, 5},
+		{"STATUS", 0.12, 8},
+		{"REGION", 0.08, 6},
+	})
+
+	var rows []table.Row
+	for _, db := range m.projectDatabases() {
+		rows = append(rows, table.Row{
+			db.Name,
+			databaseEngineName(db.EngineSlug),
+			db.VersionSlug,
+			db.SizeSlug,
+			strconv.Itoa(db.NumNodes),
+			db.Status,
+			db.RegionSlug,
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleDatabaseKey(key string) (tea.Cmd, bool) {
+	if key != "enter" {
+		return nil, false
+	}
+	db, ok := m.selectedDatabase()
+	if !ok {
+		return nil, true
+	}
+	// Open the details and load the parts the list doesn't include
+	m.viewingResourceDetails = true
+	m.detailsScroll = 0
+	m.showDBPasswords = false
+	return loadDatabaseDetails(m.client, db), true
+}
+
+func (m *model) handleDatabaseDetailsKey(key string) (tea.Cmd, bool) {
+	if key != "p" && key != "P" {
+		return nil, false
+	}
+	m.showDBPasswords = !m.showDBPasswords
+	return nil, true
+}
+
+// password returns the password, masked unless passwords are shown
+func (m model) password(password string) string {
+	if password == "" || m.showDBPasswords {
+		return password
+	}
+	return maskedPassword
+}
+
+// connectionURI returns the connection string with the password masked unless passwords are shown
+func (m model) connectionURI(conn *godo.DatabaseConnection) string {
+	if conn.URI == "" || m.showDBPasswords {
+		return conn.URI
+	}
+	// The URI may percent-encode the password, and may carry one even when
+	// conn.Password is empty, so mask the parsed userinfo
+	u, err := url.Parse(conn.URI)
+	if err != nil {
+		return maskedPassword
+	}
+	if u.User == nil {
+		return conn.URI
+	}
+	if _, ok := u.User.Password(); !ok {
+		return conn.URI
+	}
+	// url.URL would percent-encode the mask's bullets, so swap in a placeholder
+	const placeholder = "MASKED"
+	u.User = url.UserPassword(u.User.Username(), placeholder)
+	return strings.Replace(u.String(), ":"+placeholder+"@", ":"+maskedPassword+"@", 1)
+}
+
+// trustedSourceName returns a display name for a trusted source, e.g. a droplet's name for its ID
+func (m model) trustedSourceName(rule godo.DatabaseFirewallRule) string {
+	switch rule.Type {
+	case "droplet":
+		if id, err := strconv.Atoi(rule.Value); err == nil {
+			return "Droplet " + m.dropletName(id)
+		}
+	case "k8s":
+		for _, c := range m.clusters {
+			if c.ID == rule.Value {
+				return "Kubernetes " + c.Name
+			}
+		}
+		return "Kubernetes " + rule.Value
+	case "tag":
+		return "Tag " + rule.Value
+	case "app":
+		return "App " + rule.Value
+	case "ip_addr":
+		return rule.Value
+	}
+	return rule.Type + " " + rule.Value
+}
+
+func (m model) renderDatabaseDetails() string {
+	db, ok := m.selectedDatabase()
+	if !ok {
+		return ""
+	}
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+
+	// Header with status
+	statusColor := successColor
+	statusIcon := "●"
+	switch db.Status {
+	case "online":
+	case "creating", "migrating", "resizing", "forking":
+		statusColor = warningColor
+		statusIcon = "◐"
+	default:
+		statusColor = errorColor
+		statusIcon = "○"
+	}
+
+	statusStyle := lipgloss.NewStyle().Foreground(statusColor).Bold(true)
+	headerText := fmt.Sprintf("🗄️  %s  %s", db.Name, statusStyle.Render(fmt.Sprintf("%s %s", statusIcon, strings.ToUpper(db.Status))))
+
+	// Dynamic box width based on terminal size
+	boxWidth := min(m.width-4, 90)
+	if boxWidth < 50 {
+		boxWidth = 50
+	}
+	if boxWidth > m.width-4 {
+		boxWidth = m.width - 4
+	}
+
+	headerBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(headerText)
+
+	s.WriteString(headerBox)
+	s.WriteString("\n\n")
+
+	// Details
+	labelStyle := lipgloss.NewStyle().Foreground(primaryColor).Bold(true).Width(15)
+	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("255"))
+
+	createdAt := "N/A"
+	if !db.CreatedAt.IsZero() {
+		createdAt = db.CreatedAt.Format("2006-01-02 15:04:05")
+	}
+
+	details := []struct {
+		label string
+		value string
+	}{
+		{"🆔 ID:", db.ID},
+		{"🧩 Engine:", fmt.Sprintf("%s %s", databaseEngineName(db.EngineSlug), db.VersionSlug)},
+		{"📏 Size:", db.SizeSlug},
+		{"🖥️  Nodes:", strconv.Itoa(db.NumNodes)},
+		{"📍 Region:", db.RegionSlug},
+		{"💾 Storage:", fmt.Sprintf("%d GiB", db.StorageSizeMib/1024)},
+		{"📅 Created:", createdAt},
+	}
+	if vpc := m.vpcByID(db.PrivateNetworkUUID); vpc != nil {
+		details = append(details, struct {
+			label string
+			value string
+		}{"🔒 VPC:", vpc.Name})
+	}
+
+	detailsText := ""
+	for _, d := range details {
+		detailsText += fmt.Sprintf("%s %s\n", labelStyle.Render(d.label), valueStyle.Render(d.value))
+	}
+
+	// Connection strings
+	for _, conn := range []struct {
+		title string
+		conn  *godo.DatabaseConnection
+	}{
+		{"Public Connection:", db.Connection},
+		{"Private Connection:", db.PrivateConnection},
+	} {
+		if conn.conn == nil || conn.conn.Host == "" {
+			continue
+		}
+		c := conn.conn
+		detailsText += "\n" + labelStyle.Render(conn.title) + "\n"
+		detailsText += fmt.Sprintf("  Host: %s\n", c.Host)
+		detailsText += fmt.Sprintf("  Port: %d\n", c.Port)
+		detailsText += fmt.Sprintf("  User: %s\n", c.User)
+		detailsText += fmt.Sprintf("  Password: %s\n", m.password(c.Password))
+		if c.Database != "" {
+			detailsText += fmt.Sprintf("  Database: %s\n", c.Database)
+		}
+		detailsText += fmt.Sprintf("  SSL: %t\n", c.SSL)
+		detailsText += fmt.Sprintf("  URI: %s\n", m.connectionURI(c))
+	}
+
+	d := m.databaseDetails[db.ID]
+
+	// Users
+	detailsText += "\n" + labelStyle.Render("Users:") + "\n"
+	switch {
+	case d == nil:
+		detailsText += "  Loading...\n"
+	case d.usersErr != nil:
+		detailsText += fmt.Sprintf("  Error: %v\n", d.usersErr)
+	case len(d.users) == 0:
+		detailsText += "  None\n"
+	default:
+		for i, u := range d.users {
+			role := u.Role
+			if role == "" {
+				role = "normal"
+			}
+			detailsText += fmt.Sprintf("  %d. %s (%s) - password %s\n", i+1, u.Name, role, m.password(u.Password))
+		}
+	}
+
+	// Databases
+	if databaseHasDBs(db.EngineSlug) {
+		detailsText += "\n" + labelStyle.Render("Databases:") + "\n"
+		switch {
+		case d == nil:
+			detailsText += "  Loading...\n"
+		case d.dbsErr != nil:
+			detailsText += fmt.Sprintf("  Error: %v\n", d.dbsErr)
+		case len(d.dbs) == 0:
+			detailsText += "  None\n"
+		default:
+			for i, database := range d.dbs {
+				detailsText += fmt.Sprintf("  %d. %s\n", i+1, database.Name)
+			}
+		}
+	}
+
+	// Connection pools (PostgreSQL only)
+	if db.EngineSlug == "pg" {
+		detailsText += "\n" + labelStyle.Render("Connection Pools:") + "\n"
+		switch {
+		case d == nil:
+			detailsText += "  Loading...\n"
+		case d.poolsErr != nil:
+			detailsText += fmt.Sprintf("  Error: %v\n", d.poolsErr)
+		case len(d.pools) == 0:
+			detailsText += "  None\n"
+		default:
+			for i, p := range d.pools {
+				detailsText += fmt.Sprintf("  %d. %s - %s on %s, %d connections (%s mode)\n", i+1, p.Name, p.User, p.Database, p.Size, p.Mode)
+				if p.Connection != nil && p.Connection.URI != "" {
+					detailsText += fmt.Sprintf("     %s\n", m.connectionURI(p.Connection))
+				}
+			}
+		}
+	}
+
+	// Trusted sources
+	detailsText += "\n" + labelStyle.Render("Trusted Sources:") + "\n"
+	switch {
+	case d == nil:
+		detailsText += "  Loading...\n"
+	case d.rulesErr != nil:
+		detailsText += fmt.Sprintf("  Error: %v\n", d.rulesErr)
+	case len(d.rules) == 0:
+		detailsText += "  None (open to all sources)\n"
+	default:
+		for i, rule := range d.rules {
+			detailsText += fmt.Sprintf("  %d. %s\n", i+1, m.trustedSourceName(rule))
+		}
+	}
+
+	// Maintenance window
+	detailsText += "\n" + labelStyle.Render("Maintenance:") + "\n"
+	if w := db.MaintenanceWindow; w != nil {
+		detailsText += fmt.Sprintf("  Window: %s at %s UTC\n", w.Day, w.Hour)
+		if w.Pending {
+			detailsText += "  Pending updates:\n"
+			for _, desc := range w.Description {
+				detailsText += fmt.Sprintf("    - %s\n", desc)
+			}
+		}
+	} else {
+		detailsText += "  Not scheduled\n"
+	}
+
+	detailsBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	s.WriteString(detailsBox.Render(detailsText))
+	s.WriteString("\n")
+	if m.showDBPasswords {
+		s.WriteString(helpStyle.Render("[p] Hide passwords"))
+	} else {
+		s.WriteString(helpStyle.Render("[p] Show passwords"))
+	}
+
+	return s.String()
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// confirmDialog is a yes/no prompt shown over the current view
+type confirmDialog struct {
+	title     string                 // e.g. "Reboot Droplet?"
+	lines     []string               // Details shown under the title
+	warning   string                 // Optional warning, e.g. "This action cannot be undone!"
+	onConfirm func(m *model) tea.Cmd // Called when the user presses y
+}
+
+// menuItem is a single entry in a menuDialog
+type menuItem struct {
+	key      string                 // Key that selects the item, e.g. "1"
+	label    string                 // e.g. "Reboot"
+	disabled string                 // Reason the item can't be used right now ("" = enabled)
+	run      func(m *model) tea.Cmd // Called when the item is selected
+}
+
+// menuDialog is a keyed list of actions shown over the current view
+type menuDialog struct {
+	title    string
+	subtitle string
+	items    []menuItem
+}
+
+// inputDialog asks for a single line of text
+type inputDialog struct {
+	title    string
+	input    textinput.Model
+	onSubmit func(m *model, value string) tea.Cmd // Called on enter with the trimmed value
+}
+
+// pickerOption is a single choice in a pickerDialog
+type pickerOption struct {
+	label string
+	value string
+}
+
+// pickerDialog is a scrollable list to pick one option from
+type pickerDialog struct {
+	title   string
+	options []pickerOption
+	cursor  int
+	onPick  func(m *model, option pickerOption) tea.Cmd
+}
+
+// newInputDialog returns an input dialog with the value pre-filled
+func newInputDialog(title, placeholder, value string, onSubmit func(m *model, value string) tea.Cmd) *inputDialog {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.CharLimit = 255
+	input.Width = 50
+	input.SetValue(value)
+	input.Focus()
+	return &inputDialog{title: title, input: input, onSubmit: onSubmit}
+}
+
+func (m model) updateConfirmDialog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		dialog := m.confirm
+		m.confirm = nil
+		return m, dialog.onConfirm(&m)
+	case "n", "N", "esc", "q":
+		m.confirm = nil
+		return m, nil
+	}
+	return m, nil
+}
+
+func (m model) updateMenuDialog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key == "esc" || key == "q" {
+		m.menu = nil
+		return m, nil
+	}
+	for _, item := range m.menu.items {
+		if item.key != key {
+			continue
+		}
+		if item.disabled != "" {
+			m.err = fmt.Errorf("%s: %s", item.label, item.disabled)
+			return m, nil
+		}
+		m.menu = nil
+		m.err = nil
+		return m, item.run(&m)
+	}
+	return m, nil
+}
+
+func (m model) updateInputDialog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.input = nil
+		return m, nil
+	case "enter":
+		dialog := m.input
+		value := strings.TrimSpace(dialog.input.Value())
+		if value == "" {
+			return m, nil
+		}
+		m.input = nil
+		return m, dialog.onSubmit(&m, value)
+	}
+	var cmd tea.Cmd
+	m.input.input, cmd = m.input.input.Update(msg)
+	return m, cmd
+}
+
+func (m model) updatePickerDialog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := m.picker
+	switch msg.String() {
+	case "esc", "q":
+		m.picker = nil
+	case "up", "k":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case "down", "j":
+		if p.cursor < len(p.options)-1 {
+			p.cursor++
+		}
+	case "enter":
+		if len(p.options) == 0 {
+			return m, nil
+		}
+		m.picker = nil
+		return m, p.onPick(&m, p.options[p.cursor])
+	}
+	return m, nil
+}
+
+func (m model) renderInputDialog() string {
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(headerStyle.Render(m.input.title))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.input.View())
+	s.WriteString("\n\n")
+	s.WriteString(helpStyle.Render("[enter] Confirm  [esc] Cancel"))
+
+	content := lipgloss.NewStyle().
+		Width(m.width-4).
+		Padding(2, 4).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Render(s.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (m model) renderPickerDialog() string {
+	p := m.picker
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(headerStyle.Render(p.title))
+	s.WriteString("\n\n")
+
+	// Keep the cursor in a window that fits the screen
+	visible := max(3, m.height-getTopPadding()-12)
+	start := 0
+	if p.cursor >= visible {
+		start = p.cursor - visible + 1
+	}
+	end := min(start+visible, len(p.options))
+	if len(p.options) == 0 {
+		s.WriteString(helpStyle.Render("  Nothing to choose from"))
+		s.WriteString("\n")
+	}
+	for i := start; i < end; i++ {
+		if i == p.cursor {
+			s.WriteString(lipgloss.NewStyle().Foreground(highlightColor).Bold(true).Render("▶ " + p.options[i].label))
+		} else {
+			s.WriteString("  " + p.options[i].label)
+		}
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(helpStyle.Render("[↑/↓] Navigate  [enter] Select  [esc] Cancel"))
+
+	content := lipgloss.NewStyle().
+		Width(m.width-4).
+		Padding(2, 4).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Render(s.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
+
+func (m model) renderConfirmDialog() string {
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+
+	// Dynamic box width based on terminal size
+	boxWidth := min(m.width-4, 60)
+	if boxWidth < 40 {
+		boxWidth = 40
+	}
+	if boxWidth > m.width-4 {
+		boxWidth = m.width - 4
+	}
+
+	var body strings.Builder
+	body.WriteString(fmt.Sprintf("⚠️  %s\n\n", m.confirm.title))
+	for _, line := range m.confirm.lines {
+		body.WriteString(truncateString(line, boxWidth-6))
+		body.WriteString("\n")
+	}
+	if m.confirm.warning != "" {
+		body.WriteString("\n" + m.confirm.warning + "\n")
+	}
+	body.WriteString("\n[y] Yes  [n] No, cancel")
+
+	warningBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(warningColor).
+		Padding(1, 2).
+		Width(boxWidth).
+		Render(body.String())
+
+	s.WriteString(lipgloss.PlaceHorizontal(m.width, lipgloss.Center, warningBox))
+	s.WriteString("\n")
+
+	return s.String()
+}
+
+func (m model) renderMenuDialog() string {
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(headerStyle.Render(m.menu.title))
+	s.WriteString("\n\n")
+	if m.menu.subtitle != "" {
+		s.WriteString(m.menu.subtitle + "\n\n")
+	}
+
+	for _, item := range m.menu.items {
+		if item.disabled != "" {
+			s.WriteString(helpStyle.Render(fmt.Sprintf("  [%s] %s (%s)", item.key, item.label, item.disabled)))
+		} else {
+			s.WriteString(fmt.Sprintf("  %s %s", keyStyle.Render("["+item.key+"]"), item.label))
+		}
+		s.WriteString("\n")
+	}
+
+	s.WriteString("\n")
+	s.WriteString(helpStyle.Render("Press a key to choose | [esc] to cancel"))
+
+	content := lipgloss.NewStyle().
+		Width(m.width-4).
+		Padding(2, 4).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Render(s.String())
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
@@ -0,0 +1,584 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+type domainsLoadedMsg []godo.Domain
+
+// domainRecordsLoadedMsg carries the records of a domain
+type domainRecordsLoadedMsg struct {
+	domain  string
+	records []godo.DomainRecord
+}
+
+// domainRecordChangedMsg reports a created, updated or deleted record
+type domainRecordChangedMsg struct {
+	domain  string
+	message string
+}
+
+// dnsRecordTypes are the record types that can be created and edited
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "CAA"}
+
+// defaultRecordTTL is the TTL for new records, in seconds
+const defaultRecordTTL = 1800
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewDomains,
+		aliases: []string{"domain", "dns"},
+		title:   "Domains",
+		load: func(m *model) tea.Cmd {
+			if m.selectedDomain != "" {
+				return loadDomainRecords(m.client, m.selectedDomain, m.pageProgressChan)
+			}
+			return loadDomains(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			if m.selectedDomain != "" {
+				return len(m.domainRecords)
+			}
+			return len(m.projectDomains())
+		},
+		updateTable: (*model).updateDomainTable,
+		handleKey:   (*model).handleDomainKey,
+		keys: []keyHint{
+			{"c", "Create"},
+			{"e", "Edit"},
+			{"d", "Delete"},
+		},
+		renderDetails: model.renderDomainRecordDetails,
+	})
+}
+
+func loadDomains(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		domains, err := fetchDomains(context.Background(), client, reportProgress(progressChan, client, "domains"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return domainsLoadedMsg(domains)
+	})
+}
+
+func loadDomainRecords(client *godo.Client, domain string, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		records, err := fetchDomainRecords(context.Background(), client, domain, reportProgress(progressChan, client, "records"))
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list records of %s: %w", domain, err))
+		}
+		return domainRecordsLoadedMsg{domain: domain, records: records}
+	})
+}
+
+func createDomainRecord(client *godo.Client, domain string, req *godo.DomainRecordEditRequest) tea.Cmd {
+	return func() tea.Msg {
+		if _, _, err := client.Domains.CreateRecord(context.Background(), domain, req); err != nil {
+			return errMsg(fmt.Errorf("failed to create %s record %s: %w", req.Type, recordFQDN(req.Name, domain), err))
+		}
+		return domainRecordChangedMsg{domain: domain, message: fmt.Sprintf("Created %s record %s", req.Type, recordFQDN(req.Name, domain))}
+	}
+}
+
+func editDomainRecord(client *godo.Client, domain string, id int, req *godo.DomainRecordEditRequest) tea.Cmd {
+	return func() tea.Msg {
+		if _, _, err := client.Domains.EditRecord(context.Background(), domain, id, req); err != nil {
+			return errMsg(fmt.Errorf("failed to update %s record %s: %w", req.Type, recordFQDN(req.Name, domain), err))
+		}
+		return domainRecordChangedMsg{domain: domain, message: fmt.Sprintf("Updated %s record %s", req.Type, recordFQDN(req.Name, domain))}
+	}
+}
+
+func deleteDomainRecord(client *godo.Client, domain string, r godo.DomainRecord) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := client.Domains.DeleteRecord(context.Background(), domain, r.ID); err != nil {
+			return errMsg(fmt.Errorf("failed to delete %s record %s: %w", r.Type, recordFQDN(r.Name, domain), err))
+		}
+		return domainRecordChangedMsg{domain: domain, message: fmt.Sprintf("Deleted %s record %s", r.Type, recordFQDN(r.Name, domain))}
+	}
+}
+
+// recordFQDN returns the full name of a record, e.g. "www" in example.com -> "www.example.com"
+func recordFQDN(name, domain string) string {
+	if name == "@" || name == "" {
+		return domain
+	}
+	return name + "." + domain
+}
+
+// recordValue returns the record's data as it would appear in a zone file
+func recordValue(r godo.DomainRecord) string {
+	switch r.Type {
+	case "MX":
+		return fmt.Sprintf("%d %s", r.Priority, r.Data)
+	case "SRV":
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Data)
+	case "CAA":
+		return fmt.Sprintf("%d %s %q", r.Flags, r.Tag, r.Data)
+	}
+	return r.Data
+}
+
+// selectedDomainRecord returns the record under the cursor
+func (m *model) selectedDomainRecord() (godo.DomainRecord, bool) {
+	if m.selectedDomain == "" {
+		return godo.DomainRecord{}, false
+	}
+	i := m.selectedIndex(len(m.domainRecords))
+	if i < 0 {
+		return godo.DomainRecord{}, false
+	}
+	return m.domainRecords[i], true
+}
+
+// highlightedDomain returns the open domain, or the domain under the cursor in the domain list
+func (m *model) highlightedDomain() string {
+	if m.selectedDomain != "" {
+		return m.selectedDomain
+	}
+	domains := m.projectDomains()
+	i := m.selectedIndex(len(domains))
+	if i < 0 {
+		return ""
+	}
+	return domains[i].Name
+}
+
+func (m *model) updateDomainTable() {
+	if m.selectedDomain == "" {
+		columns := responsiveColumns(m.width, []columnSpec{
+			{"NAME", 0.7, 20},
+			{"TTL", 0.3, 6},
+		})
+		var rows []table.Row
+		for _, d := range m.projectDomains() {
+			rows = append(rows, table.Row{d.Name, strconv.Itoa(d.TTL)})
+		}
+		m.setTableData(columns, rows)
+		return
+	}
+
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"TYPE", 0.07, 5},
+		{"NAME", 0.30, 15},
+		{"DATA", 0.45, 20},
+		{"TTL", 0.08, 5},
+		{"ID", 0.10, 8},
+	})
+	var rows []table.Row
+	for _, r := range m.domainRecords {
+		rows = append(rows, table.Row{
+			r.Type,
+			recordFQDN(r.Name, m.selectedDomain),
+			recordValue(r),
+			strconv.Itoa(r.TTL),
+			strconv.Itoa(r.ID),
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleDomainKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "enter":
+		if m.selectedDomain != "" {
+			return nil, false // Record details
+		}
+		domain := m.highlightedDomain()
+		if domain == "" {
+			return nil, true
+		}
+		m.selectedDomain = domain
+		m.domainRecords = nil
+		m.loading = true
+		m.table.SetCursor(0)
+		m.updateTableRows()
+		return tea.Batch(loadDomainRecords(m.client, domain, m.pageProgressChan), m.spinner.Tick), true
+
+	case "esc":
+		if m.selectedDomain == "" {
+			return nil, false
+		}
+		// Back to the domain list, with the cursor on the domain
+		domain := m.selectedDomain
+		m.selectedDomain = ""
+		m.domainRecords = nil
+		m.updateTableRows()
+		for i, d := range m.projectDomains() {
+			if d.Name == domain {
+				m.table.SetCursor(i)
+				break
+			}
+		}
+		return nil, true
+
+	case "c", "C":
+		domain := m.highlightedDomain()
+		if domain == "" {
+			return nil, true
+		}
+		var options []pickerOption
+		for _, t := range dnsRecordTypes {
+			options = append(options, pickerOption{label: t, value: t})
+		}
+		m.picker = &pickerDialog{
+			title:   fmt.Sprintf("🌐 New record in %s", domain),
+			options: options,
+			onPick: func(m *model, option pickerOption) tea.Cmd {
+				req := &godo.DomainRecordEditRequest{Type: option.value, TTL: defaultRecordTTL}
+				fields := dnsRecordFields(option.value)
+				m.promptRecordField(fmt.Sprintf("🌐 New %s record in %s", option.value, domain), req, fields, 0, func(m *model) {
+					m.confirmRecordChange(domain, nil, req)
+				})
+				return nil
+			},
+		}
+		return nil, true
+
+	case "e", "E":
+		r, ok := m.selectedDomainRecord()
+		if !ok {
+			if m.selectedDomain == "" {
+				m.err = fmt.Errorf("edit: press enter to open the domain's records first")
+			}
+			return nil, true
+		}
+		if !containsString(dnsRecordTypes, r.Type) {
+			m.err = fmt.Errorf("edit: %s records can't be edited here", r.Type)
+			return nil, true
+		}
+		domain := m.selectedDomain
+		req := recordEditRequest(r)
+		m.promptRecordField(fmt.Sprintf("✏️  Edit %s record %s", r.Type, recordFQDN(r.Name, domain)), req, dnsRecordFields(r.Type), 0, func(m *model) {
+			m.confirmRecordChange(domain, &r, req)
+		})
+		return nil, true
+
+	case "d", "D":
+		r, ok := m.selectedDomainRecord()
+		if !ok {
+			if m.selectedDomain == "" {
+				m.err = fmt.Errorf("delete: press enter to open the domain's records first")
+			}
+			return nil, true
+		}
+		if r.Type == "SOA" {
+			m.err = fmt.Errorf("delete: SOA records can't be deleted")
+			return nil, true
+		}
+		domain := m.selectedDomain
+		m.confirm = &confirmDialog{
+			title: "Delete DNS Record?",
+			lines: []string{
+				fmt.Sprintf("- %s %s", r.Type, recordFQDN(r.Name, domain)),
+				fmt.Sprintf("- Data: %s", recordValue(r)),
+			},
+			warning: "This action cannot be undone!",
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(deleteDomainRecord(m.client, domain, r), m.spinner.Tick)
+			},
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// dnsRecordField is one value of a record, prompted for in turn when creating or editing
+type dnsRecordField struct {
+	label       string
+	placeholder string
+	get         func(r *godo.DomainRecordEditRequest) string
+	set         func(r *godo.DomainRecordEditRequest, value string) error // Validates and stores the value
+}
+
+// intRecordField returns a numeric field stored in the int returned by ptr
+func intRecordField(label string, minValue int, ptr func(r *godo.DomainRecordEditRequest) *int) dnsRecordField {
+	return dnsRecordField{
+		label:       label,
+		placeholder: fmt.Sprintf("%d or more", minValue),
+		get: func(r *godo.DomainRecordEditRequest) string {
+			return strconv.Itoa(*ptr(r))
+		},
+		set: func(r *godo.DomainRecordEditRequest, value string) error {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < minValue {
+				return fmt.Errorf("%s must be a number of at least %d", strings.ToLower(label), minValue)
+			}
+			*ptr(r) = n
+			return nil
+		},
+	}
+}
+
+// dnsRecordFields returns the fields of a record type, in prompt order
+func dnsRecordFields(recordType string) []dnsRecordField {
+	name := dnsRecordField{
+		label:       "Name",
+		placeholder: "@ for the domain itself, e.g. www",
+		get:         func(r *godo.DomainRecordEditRequest) string { return r.Name },
+		set: func(r *godo.DomainRecordEditRequest, value string) error {
+			r.Name = value
+			return nil
+		},
+	}
+
+	dataLabel, dataPlaceholder := "Data", ""
+	switch recordType {
+	case "A":
+		dataLabel, dataPlaceholder = "IPv4 Address", "e.g. 203.0.113.10"
+	case "AAAA":
+		dataLabel, dataPlaceholder = "IPv6 Address", "e.g. 2001:db8::10"
+	case "CNAME":
+		dataLabel, dataPlaceholder = "Hostname", "e.g. www.example.com. or @"
+	case "MX":
+		dataLabel, dataPlaceholder = "Mail Server", "e.g. mail.example.com."
+	case "TXT":
+		dataLabel, dataPlaceholder = "Value", "e.g. v=spf1 include:_spf.example.com ~all"
+	case "SRV":
+		dataLabel, dataPlaceholder = "Target", "e.g. sip.example.com."
+	case "CAA":
+		dataLabel, dataPlaceholder = "Value", "e.g. letsencrypt.org"
+	}
+	data := dnsRecordField{
+		label:       dataLabel,
+		placeholder: dataPlaceholder,
+		get:         func(r *godo.DomainRecordEditRequest) string { return r.Data },
+		set: func(r *godo.DomainRecordEditRequest, value string) error {
+			ip := net.ParseIP(value)
+			if recordType == "A" && (ip == nil || ip.To4() == nil) {
+				return fmt.Errorf("%q is not an IPv4 address", value)
+			}
+			if recordType == "AAAA" && (ip == nil || ip.To4() != nil) {
+				return fmt.Errorf("%q is not an IPv6 address", value)
+			}
+			r.Data = value
+			return nil
+		},
+	}
+
+	priority := intRecordField("Priority", 0, func(r *godo.DomainRecordEditRequest) *int { return &r.Priority })
+	ttl := intRecordField("TTL", 30, func(r *godo.DomainRecordEditRequest) *int { return &r.TTL })
+
+	switch recordType {
+	case "MX":
+		return []dnsRecordField{name, priority, data, ttl}
+	case "SRV":
+		return []dnsRecordField{
+			name,
+			priority,
+			intRecordField("Weight", 0, func(r *godo.DomainRecordEditRequest) *int { return &r.Weight }),
+			intRecordField("Port", 1, func(r *godo.DomainRecordEditRequest) *int { return &r.Port }),
+			data,
+			ttl,
+		}
+	case "CAA":
+		tag := dnsRecordField{
+			label:       "Tag",
+			placeholder: "issue, issuewild or iodef",
+			get:         func(r *godo.DomainRecordEditRequest) string { return r.Tag },
+			set: func(r *godo.DomainRecordEditRequest, value string) error {
+				if !containsString([]string{"issue", "issuewild", "iodef"}, value) {
+					return fmt.Errorf("tag must be issue, issuewild or iodef")
+				}
+				r.Tag = value
+				return nil
+			},
+		}
+		return []dnsRecordField{
+			name,
+			intRecordField("Flags", 0, func(r *godo.DomainRecordEditRequest) *int { return &r.Flags }),
+			tag,
+			data,
+			ttl,
+		}
+	}
+	return []dnsRecordField{name, data, ttl}
+}
+
+// recordEditRequest returns an edit request with the record's current values
+func recordEditRequest(r godo.DomainRecord) *godo.DomainRecordEditRequest {
+	return &godo.DomainRecordEditRequest{
+		Type:     r.Type,
+		Name:     r.Name,
+		Data:     r.Data,
+		Priority: r.Priority,
+		Port:     r.Port,
+		TTL:      r.TTL,
+		Weight:   r.Weight,
+		Flags:    r.Flags,
+		Tag:      r.Tag,
+	}
+}
+
+// promptRecordField asks for fields[i] and then the remaining fields, calling
+// done once all of them are set on req. An invalid value asks again.
+func (m *model) promptRecordField(title string, req *godo.DomainRecordEditRequest, fields []dnsRecordField, i int, done func(m *model)) {
+	field := fields[i]
+	m.input = newInputDialog(fmt.Sprintf("%s (%s)", title, field.label), field.placeholder, field.get(req), func(m *model, value string) tea.Cmd {
+		if err := field.set(req, value); err != nil {
+			m.err = err
+			m.promptRecordField(title, req, fields, i, done)
+			return nil
+		}
+		m.err = nil
+		if i+1 < len(fields) {
+			m.promptRecordField(title, req, fields, i+1, done)
+		} else {
+			done(m)
+		}
+		return nil
+	})
+}
+
+// recordDiff lists the record's fields, marking removed values with "-" and
+// new values with "+". Without an old record every field is new.
+func recordDiff(old, req *godo.DomainRecordEditRequest) (lines []string, changed bool) {
+	for _, field := range dnsRecordFields(req.Type) {
+		value := field.get(req)
+		if old == nil {
+			lines = append(lines, fmt.Sprintf("+ %s: %s", field.label, value))
+			changed = true
+			continue
+		}
+		if before := field.get(old); before != value {
+			lines = append(lines, fmt.Sprintf("- %s: %s", field.label, before), fmt.Sprintf("+ %s: %s", field.label, value))
+			changed = true
+		} else {
+			lines = append(lines, fmt.Sprintf("  %s: %s", field.label, value))
+		}
+	}
+	return lines, changed
+}
+
+// confirmRecordChange shows the diff between the record and req and asks
+// before creating (old == nil) or updating the record
+func (m *model) confirmRecordChange(domain string, old *godo.DomainRecord, req *godo.DomainRecordEditRequest) {
+	var before *godo.DomainRecordEditRequest
+	if old != nil {
+		before = recordEditRequest(*old)
+	}
+	lines, changed := recordDiff(before, req)
+	if !changed {
+		m.successMsg = fmt.Sprintf("No changes to %s record %s", req.Type, recordFQDN(req.Name, domain))
+		return
+	}
+
+	title, warning := fmt.Sprintf("Create %s record %s?", req.Type, recordFQDN(req.Name, domain)), ""
+	if old != nil {
+		title = fmt.Sprintf("Update %s record %s?", old.Type, recordFQDN(old.Name, domain))
+		warning = fmt.Sprintf("Resolvers may keep serving the old record for up to its TTL (%ds).", old.TTL)
+	}
+	m.confirm = &confirmDialog{
+		title:   title,
+		lines:   lines,
+		warning: warning,
+		onConfirm: func(m *model) tea.Cmd {
+			m.loading = true
+			if old != nil {
+				return tea.Batch(editDomainRecord(m.client, domain, old.ID, req), m.spinner.Tick)
+			}
+			return tea.Batch(createDomainRecord(m.client, domain, req), m.spinner.Tick)
+		},
+	}
+}
+
+// getPublicIPv6 returns the droplet's public IPv6 address, if IPv6 is enabled
+func getPublicIPv6(d godo.Droplet) string {
+	for _, v6 := range d.Networks.V6 {
+		if v6.Type == "public" {
+			return v6.IPAddress
+		}
+	}
+	return ""
+}
+
+// startDropletDNSRecord loads the domains and then asks where to point a record at the droplet
+func (m *model) startDropletDNSRecord(d godo.Droplet) tea.Cmd {
+	if getPublicIP(d) == "" && getPublicIPv6(d) == "" {
+		m.err = fmt.Errorf("droplet %s has no public IP address", d.Name)
+		return nil
+	}
+	m.pendingDNSDroplet = &d
+	m.loading = true
+	return tea.Batch(loadDomains(m.client, m.pageProgressChan), m.spinner.Tick)
+}
+
+// openDropletDNSPicker asks for the domain, record name and address type, then
+// confirms an A or AAAA record pointing at the droplet
+func (m *model) openDropletDNSPicker(d godo.Droplet) {
+	if len(m.domains) == 0 {
+		m.err = fmt.Errorf("no domains in this account, add one in the control panel first")
+		return
+	}
+	var options []pickerOption
+	for _, domain := range m.domains {
+		options = append(options, pickerOption{label: domain.Name, value: domain.Name})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🌐 Point a DNS record at %s", d.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			domain := option.value
+			m.input = newInputDialog(fmt.Sprintf("🌐 Record name in %s", domain), "@ for the domain itself, e.g. www", d.Name, func(m *model, name string) tea.Cmd {
+				var addresses []pickerOption
+				if ip := getPublicIP(d); ip != "" {
+					addresses = append(addresses, pickerOption{label: "A → " + ip, value: "A " + ip})
+				}
+				if ip := getPublicIPv6(d); ip != "" {
+					addresses = append(addresses, pickerOption{label: "AAAA → " + ip, value: "AAAA " + ip})
+				}
+				create := func(m *model, option pickerOption) tea.Cmd {
+					recordType, ip, _ := strings.Cut(option.value, " ")
+					m.confirmRecordChange(domain, nil, &godo.DomainRecordEditRequest{Type: recordType, Name: name, Data: ip, TTL: defaultRecordTTL})
+					return nil
+				}
+				if len(addresses) == 1 {
+					return create(m, addresses[0])
+				}
+				m.picker = &pickerDialog{
+					title:   fmt.Sprintf("🌐 Record type for %s", recordFQDN(name, domain)),
+					options: addresses,
+					onPick:  create,
+				}
+				return nil
+			})
+			return nil
+		},
+	}
+}
+
+func (m model) renderDomainRecordDetails() string {
+	r, ok := m.selectedDomainRecord()
+	if !ok {
+		return ""
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("🌐 %s Record: %s", r.Type, recordFQDN(r.Name, m.selectedDomain))))
+	b.WriteString("\n\n")
+	pairs := [][2]string{
+		{"ID", strconv.Itoa(r.ID)},
+		{"Type", r.Type},
+	}
+	if containsString(dnsRecordTypes, r.Type) {
+		req := recordEditRequest(r)
+		for _, field := range dnsRecordFields(r.Type) {
+			pairs = append(pairs, [2]string{field.label, field.get(req)})
+		}
+	} else {
+		pairs = append(pairs, [2]string{"Name", r.Name}, [2]string{"Data", r.Data}, [2]string{"TTL", strconv.Itoa(r.TTL)})
+	}
+	b.WriteString(renderDetailsSection("Record", pairs))
+	return b.String()
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// actionPollInterval is how often a running droplet action is polled
+const actionPollInterval = 3 * time.Second
+
+// actionErrored is the status of a droplet action that failed (godo has no constant for it)
+const actionErrored = "errored"
+
+// actionPollRetries is how many polls in a row may fail before the action is given up on
+const actionPollRetries = 5
+
+// dropletActionFunc starts a droplet action, e.g. client.DropletActions.Reboot
+type dropletActionFunc func(ctx context.Context, client *godo.Client, dropletID int) (*godo.Action, *godo.Response, error)
+
+// dropletActionStep is one droplet action in a tracked sequence
+type dropletActionStep struct {
+	label    string // e.g. "Power off"
+	run      dropletActionFunc
+	recovery string // If set, the step also runs when a step after the first fails, and this is what's left if it can't, e.g. "droplet left powered off"
+}
+
+// actionPollFunc fetches an action by ID, e.g. for volume actions
+type actionPollFunc func(ctx context.Context, client *godo.Client, actionID int) (*godo.Action, *godo.Response, error)
+
+// trackedAction is a sequence of droplet (or volume) actions being run one
+// after another, each polled until it completes or errors
+type trackedAction struct {
+	client     *godo.Client // Client of the account the droplet belongs to
+	dropletID  int
+	targetName string         // Droplet or volume name, for messages
+	poll       actionPollFunc // Optional, defaults to polling droplet actions
+	title      string         // e.g. "Reboot"
+	steps      []dropletActionStep
+	current    int                    // Index of the running step
+	status     string                 // Status of the running step's action
+	pollErrors int                    // Polls in a row that failed
+	failure    error                  // Set while a recovery step runs after a failed step
+	onDone     func(m *model) tea.Cmd // Optional, called after the last step completes
+}
+
+// dropletActionMsg reports a started or polled droplet action
+type dropletActionMsg struct {
+	action   *godo.Action
+	actionID int // The polled action, 0 when starting a step
+	err      error
+}
+
+// dropletPowerAction describes an entry in the droplet action menu
+type dropletPowerAction struct {
+	key     string
+	label   string
+	warning string // Shown in the confirmation dialog
+	needsOn bool   // Requires the droplet to be active (otherwise requires it to be off)
+	anyTime bool   // Allowed in any state
+	run     dropletActionFunc
+}
+
+var dropletPowerActions = []dropletPowerAction{
+	{key: "1", label: "Reboot", needsOn: true, run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+		return c.DropletActions.Reboot(ctx, id)
+	}},
+	{key: "2", label: "Power off", needsOn: true, warning: "Like pulling the power cord - unsaved data may be lost.", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+		return c.DropletActions.PowerOff(ctx, id)
+	}},
+	{key: "3", label: "Power on", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+		return c.DropletActions.PowerOn(ctx, id)
+	}},
+	{key: "4", label: "Shut down", needsOn: true, run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+		return c.DropletActions.Shutdown(ctx, id)
+	}},
+	{key: "5", label: "Power cycle", anyTime: true, warning: "Hard reset - unsaved data may be lost.", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+		return c.DropletActions.PowerCycle(ctx, id)
+	}},
+}
+
+// openDropletActionMenu shows the power action menu for the droplet
+func (m *model) openDropletActionMenu(d godo.Droplet) {
+	var items []menuItem
+	for _, action := range dropletPowerActions {
+		action := action
+		disabled := ""
+		switch {
+		case m.runningAction != nil:
+			disabled = "another action is in progress"
+		case action.anyTime:
+		case action.needsOn && d.Status != "active":
+			disabled = "droplet is " + d.Status
+		case !action.needsOn && d.Status != "off":
+			disabled = "droplet is " + d.Status
+		}
+		items = append(items, menuItem{
+			key:      action.key,
+			label:    action.label,
+			disabled: disabled,
+			run: func(m *model) tea.Cmd {
+				m.confirm = &confirmDialog{
+					title:   fmt.Sprintf("%s Droplet?", action.label),
+					lines:   []string{fmt.Sprintf("Droplet: %s", d.Name), fmt.Sprintf("ID: %d", d.ID)},
+					warning: action.warning,
+					onConfirm: func(m *model) tea.Cmd {
+						return m.startTrackedAction(d, action.label, []dropletActionStep{{label: action.label, run: action.run}})
+					},
+				}
+				return nil
+			},
+		})
+	}
+
+	busy := ""
+	if m.runningAction != nil {
+		busy = "another action is in progress"
+	}
+	items = append(items, menuItem{
+		key:      "6",
+		label:    "Resize",
+		disabled: busy,
+		run: func(m *model) tea.Cmd {
+			return m.startResize(d)
+		},
+	}, menuItem{
+		key:      "7",
+		label:    "Snapshot",
+		disabled: busy,
+		run: func(m *model) tea.Cmd {
+			m.promptDropletSnapshot(d)
+			return nil
+		},
+	})
+
+	m.menu = &menuDialog{
+		title:    "⚡ Droplet Actions",
+		subtitle: fmt.Sprintf("Droplet: %s (%s)", d.Name, d.Status),
+		items:    items,
+	}
+}
+
+// startTrackedAction runs the steps in order against the droplet, tracking progress in the status bar
+func (m *model) startTrackedAction(d godo.Droplet, title string, steps []dropletActionStep) tea.Cmd {
+	m.runningAction = &trackedAction{
+		client:     m.client,
+		dropletID:  d.ID,
+		targetName: d.Name,
+		title:      title,
+		steps:      steps,
+	}
+	m.err = nil
+	m.successMsg = ""
+	return tea.Batch(runDropletActionStep(m.client, d.ID, steps[0]), m.spinner.Tick)
+}
+
+func runDropletActionStep(client *godo.Client, dropletID int, step dropletActionStep) tea.Cmd {
+	return func() tea.Msg {
+		action, _, err := step.run(context.Background(), client, dropletID)
+		return dropletActionMsg{action: action, err: err}
+	}
+}
+
+func pollDropletAction(client *godo.Client, t *trackedAction, actionID int) tea.Cmd {
+	dropletID, poll := t.dropletID, t.poll
+	return func() tea.Msg {
+		time.Sleep(actionPollInterval)
+		if poll != nil {
+			action, _, err := poll(context.Background(), client, actionID)
+			return dropletActionMsg{action: action, actionID: actionID, err: err}
+		}
+		action, _, err := client.DropletActions.Get(context.Background(), dropletID, actionID)
+		return dropletActionMsg{action: action, actionID: actionID, err: err}
+	}
+}
+
+// handleDropletAction advances the running action: keeps polling, moves to the next step, or finishes
+func (m model) handleDropletAction(msg dropletActionMsg) (tea.Model, tea.Cmd) {
+	t := m.runningAction
+	if t == nil {
+		return m, nil
+	}
+	step := t.steps[t.current]
+
+	if msg.err != nil {
+		if isAuthError(msg.err) {
+			m.authFailed = true
+		} else if msg.actionID != 0 && t.pollErrors < actionPollRetries {
+			// The action keeps running whether or not a poll gets through
+			t.pollErrors++
+			return m, pollDropletAction(t.client, t, msg.actionID)
+		}
+		return m.failTrackedAction(fmt.Errorf("%s failed for %s at step %s: %w", t.title, t.targetName, step.label, msg.err))
+	}
+
+	t.pollErrors = 0
+	t.status = msg.action.Status
+	switch msg.action.Status {
+	case godo.ActionCompleted:
+		if t.failure != nil {
+			// The recovery step worked, but the action as a whole failed
+			m.runningAction = nil
+			m.err = fmt.Errorf("%w (%s ran to recover)", t.failure, step.label)
+			return m, loadDroplets(m.client, m.pageProgressChan)
+		}
+		if t.current+1 < len(t.steps) {
+			t.current++
+			t.status = ""
+			return m, runDropletActionStep(t.client, t.dropletID, t.steps[t.current])
+		}
+		m.runningAction = nil
+		m.successMsg = fmt.Sprintf("✅ %s completed for '%s'", t.title, t.targetName)
+		if t.onDone != nil {
+			return m, tea.Batch(loadDroplets(m.client, m.pageProgressChan), t.onDone(&m))
+		}
+		return m, loadDroplets(m.client, m.pageProgressChan)
+	case actionErrored:
+		return m.failTrackedAction(fmt.Errorf("%s errored for %s at step %s (action %d)", t.title, t.targetName, step.label, msg.action.ID))
+	default:
+		return m, pollDropletAction(t.client, t, msg.action.ID)
+	}
+}
+
+// failTrackedAction ends the running action with the error. If a step after
+// the first failed, a later recovery step (e.g. powering the droplet back on)
+// runs first, so the droplet isn't left half way through the sequence.
+func (m model) failTrackedAction(err error) (tea.Model, tea.Cmd) {
+	t := m.runningAction
+	if t.failure == nil && t.current > 0 {
+		for i := t.current + 1; i < len(t.steps); i++ {
+			if t.steps[i].recovery != "" {
+				t.failure = err
+				t.current, t.status, t.pollErrors = i, "", 0
+				m.err = fmt.Errorf("%w - running %s to recover", err, t.steps[i].label)
+				return m, runDropletActionStep(t.client, t.dropletID, t.steps[i])
+			}
+		}
+	}
+
+	m.runningAction = nil
+	step := t.steps[t.current]
+	switch {
+	case t.failure != nil:
+		err = fmt.Errorf("%w; %s failed too, %s: %v", t.failure, step.label, step.recovery, err)
+	case step.recovery != "":
+		err = fmt.Errorf("%w, %s", err, step.recovery)
+	}
+	m.err = err
+	return m, loadDroplets(m.client, m.pageProgressChan)
+}
+
+// statusText describes the running action for the status bar
+func (t *trackedAction) statusText() string {
+	status := t.status
+	if status == "" {
+		status = "starting"
+	}
+	if len(t.steps) == 1 {
+		return fmt.Sprintf("%s %s: %s", t.title, t.targetName, status)
+	}
+	return fmt.Sprintf("%s %s: step %d/%d %s (%s)", t.title, t.targetName, t.current+1, len(t.steps), t.steps[t.current].label, status)
+}
@@ -0,0 +1,549 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// publicIPURL returns the caller's public IP address as plain text. It is
+// only asked when settings.public_ip_lookup isn't turned off.
+const publicIPURL = "https://api.ipify.org"
+
+type firewallsLoadedMsg []godo.Firewall
+
+// firewallsFailedMsg reports a failed firewall load, shown in droplet details
+type firewallsFailedMsg struct {
+	err error
+}
+
+// firewallChangedMsg reports a finished firewall change; the list is reloaded
+type firewallChangedMsg string
+
+// publicIPMsg reports the user's public IP, used to check SSH access
+type publicIPMsg struct {
+	ip  string
+	err error
+}
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewFirewalls,
+		aliases: []string{"firewall", "fw"},
+		title:   "Firewalls",
+		load: func(m *model) tea.Cmd {
+			return loadFirewalls(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.firewalls)
+		},
+		updateTable: (*model).updateFirewallTable,
+		handleKey:   (*model).handleFirewallKey,
+		keys: []keyHint{
+			{"+", "Add rule"},
+			{"x", "Remove rule"},
+			{"a", "Assign"},
+			{"u", "Unassign"},
+		},
+		renderDetails: model.renderFirewallDetails,
+	})
+}
+
+func loadFirewalls(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		firewalls, err := fetchFirewalls(context.Background(), client, reportProgress(progressChan, client, "firewalls"))
+		if err != nil {
+			return firewallsFailedMsg{err: err}
+		}
+		return firewallsLoadedMsg(firewalls)
+	})
+}
+
+func loadPublicIP() tea.Cmd {
+	return func() tea.Msg {
+		client := &http.Client{Timeout: 5 * time.Second}
+		resp, err := client.Get(publicIPURL)
+		if err != nil {
+			return publicIPMsg{err: err}
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 64))
+		if err != nil {
+			return publicIPMsg{err: err}
+		}
+		ip := strings.TrimSpace(string(body))
+		if net.ParseIP(ip) == nil {
+			return publicIPMsg{err: fmt.Errorf("unexpected response from %s: %q", publicIPURL, ip)}
+		}
+		return publicIPMsg{ip: ip}
+	}
+}
+
+// updateFirewall runs a firewall change and reports success with the message
+func updateFirewall(client *godo.Client, fw godo.Firewall, success string, change func(ctx context.Context, c *godo.Client) (*godo.Response, error)) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := change(context.Background(), client); err != nil {
+			return errMsg(fmt.Errorf("failed to update firewall %s: %w", fw.Name, err))
+		}
+		return firewallChangedMsg(success)
+	}
+}
+
+// selectedFirewall returns the firewall under the cursor
+func (m *model) selectedFirewall() (godo.Firewall, bool) {
+	i := m.selectedIndex(len(m.firewalls))
+	if i < 0 {
+		return godo.Firewall{}, false
+	}
+	return m.firewalls[i], true
+}
+
+// firewallsForDroplet returns the firewalls that apply to the droplet, directly or by tag
+func firewallsForDroplet(firewalls []godo.Firewall, d godo.Droplet) []godo.Firewall {
+	var applied []godo.Firewall
+	for _, fw := range firewalls {
+		matches := false
+		for _, id := range fw.DropletIDs {
+			if id == d.ID {
+				matches = true
+			}
+		}
+		for _, tag := range fw.Tags {
+			if containsString(d.Tags, tag) {
+				matches = true
+			}
+		}
+		if matches {
+			applied = append(applied, fw)
+		}
+	}
+	return applied
+}
+
+// portRangeCovers reports whether a rule's port range ("22", "8000-9000", "all") includes the port
+func portRangeCovers(portRange string, port int) bool {
+	if portRange == "" || portRange == "all" || portRange == "0" {
+		return true
+	}
+	if from, to, ok := strings.Cut(portRange, "-"); ok {
+		lo, err1 := strconv.Atoi(from)
+		hi, err2 := strconv.Atoi(to)
+		return err1 == nil && err2 == nil && lo <= port && port <= hi
+	}
+	p, err := strconv.Atoi(portRange)
+	return err == nil && p == port
+}
+
+// addressMatches reports whether a rule address (IP or CIDR) includes the IP
+func addressMatches(address string, ip net.IP) bool {
+	if _, network, err := net.ParseCIDR(address); err == nil {
+		return network.Contains(ip)
+	}
+	return net.ParseIP(address).Equal(ip)
+}
+
+// sshAccess describes whether the firewalls let the IP reach port 22
+func sshAccess(firewalls []godo.Firewall, publicIP string) string {
+	if len(firewalls) == 0 {
+		return "open (no cloud firewall applies)"
+	}
+	ip := net.ParseIP(publicIP)
+	var fromAnywhere []string
+	for _, fw := range firewalls {
+		for _, rule := range fw.InboundRules {
+			if rule.Protocol != "tcp" || !portRangeCovers(rule.PortRange, 22) || rule.Sources == nil {
+				continue
+			}
+			for _, address := range rule.Sources.Addresses {
+				if ip != nil && addressMatches(address, ip) {
+					return fmt.Sprintf("✅ allowed from %s by %s (%s)", publicIP, fw.Name, address)
+				}
+				if address == "0.0.0.0/0" || address == "::/0" {
+					fromAnywhere = append(fromAnywhere, fw.Name)
+				}
+			}
+		}
+	}
+	if ip == nil {
+		if len(fromAnywhere) > 0 {
+			return fmt.Sprintf("✅ allowed from anywhere by %s", fromAnywhere[0])
+		}
+		return "❓ your public IP is unknown and no rule allows port 22 from anywhere"
+	}
+	return fmt.Sprintf("❌ blocked: no inbound rule allows tcp/22 from %s", publicIP)
+}
+
+// renderDropletFirewalls returns the firewalls and SSH access lines for droplet details
+func (m model) renderDropletFirewalls(d godo.Droplet) (firewalls, ssh string) {
+	if m.firewalls == nil {
+		if m.firewallsErr != nil {
+			return fmt.Sprintf("❌ failed to load: %v", m.firewallsErr), "❓ unknown, firewalls not loaded"
+		}
+		return "loading...", "checking..."
+	}
+	applied := firewallsForDroplet(m.firewalls, d)
+	names := make([]string, len(applied))
+	for i, fw := range applied {
+		names[i] = fw.Name
+	}
+	firewalls = strings.Join(names, ", ")
+	if firewalls == "" {
+		firewalls = "none"
+	}
+	if m.publicIP == "" && m.publicIPErr == nil && m.config.publicIPLookup() {
+		return firewalls, "checking..."
+	}
+	return firewalls, sshAccess(applied, m.publicIP)
+}
+
+// formatRuleTargets renders a rule's sources or destinations, e.g. "0.0.0.0/0, tag:web"
+func (m *model) formatRuleTargets(addresses, tags []string, dropletIDs []int, loadBalancers, clusters []string) string {
+	var targets []string
+	targets = append(targets, addresses...)
+	for _, tag := range tags {
+		targets = append(targets, "tag:"+tag)
+	}
+	for _, id := range dropletIDs {
+		targets = append(targets, "droplet:"+m.dropletName(id))
+	}
+	for _, uid := range loadBalancers {
+		targets = append(targets, "lb:"+uid)
+	}
+	for _, id := range clusters {
+		targets = append(targets, "k8s:"+id)
+	}
+	if len(targets) == 0 {
+		return "-"
+	}
+	return strings.Join(targets, ", ")
+}
+
+// parseRuleTargets splits "0.0.0.0/0, tag:web" into addresses and tags
+func parseRuleTargets(s string) (addresses, tags []string, err error) {
+	for _, target := range parseTags(s) {
+		if tag, ok := strings.CutPrefix(target, "tag:"); ok {
+			tags = append(tags, tag)
+			continue
+		}
+		if net.ParseIP(target) == nil {
+			if _, _, err := net.ParseCIDR(target); err != nil {
+				return nil, nil, fmt.Errorf("invalid address %q (use an IP, a CIDR or tag:<name>)", target)
+			}
+		}
+		addresses = append(addresses, target)
+	}
+	if len(addresses) == 0 && len(tags) == 0 {
+		return nil, nil, fmt.Errorf("at least one address or tag is required")
+	}
+	return addresses, tags, nil
+}
+
+func ruleLabel(protocol, ports string) string {
+	if protocol == "icmp" {
+		return "icmp"
+	}
+	if ports == "" || ports == "0" {
+		ports = "all"
+	}
+	return protocol + " " + ports
+}
+
+func (m *model) inboundRuleText(rule godo.InboundRule) string {
+	s := rule.Sources
+	if s == nil {
+		s = &godo.Sources{}
+	}
+	return fmt.Sprintf("in  %s from %s", ruleLabel(rule.Protocol, rule.PortRange), m.formatRuleTargets(s.Addresses, s.Tags, s.DropletIDs, s.LoadBalancerUIDs, s.KubernetesIDs))
+}
+
+func (m *model) outboundRuleText(rule godo.OutboundRule) string {
+	d := rule.Destinations
+	if d == nil {
+		d = &godo.Destinations{}
+	}
+	return fmt.Sprintf("out %s to %s", ruleLabel(rule.Protocol, rule.PortRange), m.formatRuleTargets(d.Addresses, d.Tags, d.DropletIDs, d.LoadBalancerUIDs, d.KubernetesIDs))
+}
+
+func (m *model) firewallDroplets(fw godo.Firewall) string {
+	if len(fw.DropletIDs) == 0 {
+		return "-"
+	}
+	names := make([]string, len(fw.DropletIDs))
+	for i, id := range fw.DropletIDs {
+		names[i] = m.dropletName(id)
+	}
+	return strings.Join(names, ",")
+}
+
+func (m *model) updateFirewallTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.22, 15},
+		{"STATUS", 0.10, 8},
+		{"INBOUND", 0.10, 8},
+		{"OUTBOUND", 0.10, 9},
+		{"DROPLETS", 0.28, 12},
+		{"TAGS", 0.20, 10},
+	})
+
+	var rows []table.Row
+	for _, fw := range m.firewalls {
+		tags := strings.Join(fw.Tags, ",")
+		if tags == "" {
+			tags = "-"
+		}
+		rows = append(rows, table.Row{
+			fw.Name,
+			fw.Status,
+			fmt.Sprintf("%d rules", len(fw.InboundRules)),
+			fmt.Sprintf("%d rules", len(fw.OutboundRules)),
+			m.firewallDroplets(fw),
+			tags,
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleFirewallKey(key string) (tea.Cmd, bool) {
+	var handler func(fw godo.Firewall)
+	switch key {
+	case "+", "=":
+		handler = m.openAddRule
+	case "x", "X":
+		handler = m.openRemoveRule
+	case "a", "A":
+		handler = m.openFirewallAssign
+	case "u", "U":
+		handler = m.openFirewallUnassign
+	default:
+		return nil, false
+	}
+	fw, ok := m.selectedFirewall()
+	if !ok {
+		return nil, true
+	}
+	m.err = nil
+	handler(fw)
+	return nil, true
+}
+
+// openAddRule runs the add rule flow: direction, protocol, ports, sources/destinations
+func (m *model) openAddRule(fw godo.Firewall) {
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🛡️  Add rule to %s", fw.Name),
+		options: []pickerOption{{label: "Inbound", value: "in"}, {label: "Outbound", value: "out"}},
+		onPick: func(m *model, direction pickerOption) tea.Cmd {
+			m.picker = &pickerDialog{
+				title:   "🛡️  Protocol",
+				options: []pickerOption{{label: "TCP", value: "tcp"}, {label: "UDP", value: "udp"}, {label: "ICMP", value: "icmp"}},
+				onPick: func(m *model, protocol pickerOption) tea.Cmd {
+					if protocol.value == "icmp" {
+						m.promptRuleTargets(fw, direction.value, protocol.value, "")
+						return nil
+					}
+					m.input = newInputDialog("🛡️  Ports (e.g. 22, 8000-9000 or all)", "ports", "22", func(m *model, ports string) tea.Cmd {
+						m.promptRuleTargets(fw, direction.value, protocol.value, ports)
+						return nil
+					})
+					return nil
+				},
+			}
+			return nil
+		},
+	}
+}
+
+func (m *model) promptRuleTargets(fw godo.Firewall, direction, protocol, ports string) {
+	title := "🛡️  Sources (addresses, CIDRs or tag:<name>, comma-separated)"
+	if direction == "out" {
+		title = "🛡️  Destinations (addresses, CIDRs or tag:<name>, comma-separated)"
+	}
+	m.input = newInputDialog(title, "0.0.0.0/0, ::/0", "0.0.0.0/0, ::/0", func(m *model, value string) tea.Cmd {
+		addresses, tags, err := parseRuleTargets(value)
+		if err != nil {
+			m.err = err
+			return nil
+		}
+		rules := &godo.FirewallRulesRequest{}
+		if direction == "in" {
+			rules.InboundRules = []godo.InboundRule{{Protocol: protocol, PortRange: ports, Sources: &godo.Sources{Addresses: addresses, Tags: tags}}}
+		} else {
+			rules.OutboundRules = []godo.OutboundRule{{Protocol: protocol, PortRange: ports, Destinations: &godo.Destinations{Addresses: addresses, Tags: tags}}}
+		}
+		m.loading = true
+		success := fmt.Sprintf("✅ Added %s rule to '%s'", ruleLabel(protocol, ports), fw.Name)
+		return tea.Batch(updateFirewall(m.client, fw, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+			return c.Firewalls.AddRules(ctx, fw.ID, rules)
+		}), m.spinner.Tick)
+	})
+}
+
+// openRemoveRule lets the user pick one of the firewall's rules to remove
+func (m *model) openRemoveRule(fw godo.Firewall) {
+	var options []pickerOption
+	for i, rule := range fw.InboundRules {
+		options = append(options, pickerOption{label: m.inboundRuleText(rule), value: fmt.Sprintf("in:%d", i)})
+	}
+	for i, rule := range fw.OutboundRules {
+		options = append(options, pickerOption{label: m.outboundRuleText(rule), value: fmt.Sprintf("out:%d", i)})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🛡️  Remove rule from %s", fw.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			direction, index, _ := strings.Cut(option.value, ":")
+			i, _ := strconv.Atoi(index)
+			rules := &godo.FirewallRulesRequest{}
+			if direction == "in" {
+				rules.InboundRules = []godo.InboundRule{fw.InboundRules[i]}
+			} else {
+				rules.OutboundRules = []godo.OutboundRule{fw.OutboundRules[i]}
+			}
+			m.confirm = &confirmDialog{
+				title:   "Remove Firewall Rule?",
+				lines:   []string{fmt.Sprintf("Firewall: %s", fw.Name), fmt.Sprintf("Rule: %s", option.label)},
+				warning: "Traffic allowed only by this rule will be blocked.",
+				onConfirm: func(m *model) tea.Cmd {
+					m.loading = true
+					success := fmt.Sprintf("✅ Removed rule from '%s'", fw.Name)
+					return tea.Batch(updateFirewall(m.client, fw, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+						return c.Firewalls.RemoveRules(ctx, fw.ID, rules)
+					}), m.spinner.Tick)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+// openFirewallAssign lets the user apply the firewall to a droplet or a tag
+func (m *model) openFirewallAssign(fw godo.Firewall) {
+	options := []pickerOption{{label: "Tag...", value: "tag"}}
+	for _, d := range m.droplets {
+		if containsInt(fw.DropletIDs, d.ID) {
+			continue
+		}
+		options = append(options, pickerOption{label: fmt.Sprintf("Droplet: %s", d.Name), value: strconv.Itoa(d.ID)})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🛡️  Apply %s to", fw.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			if option.value == "tag" {
+				m.input = newInputDialog(fmt.Sprintf("🛡️  Apply %s to droplets tagged", fw.Name), "tag", "", func(m *model, tag string) tea.Cmd {
+					m.loading = true
+					success := fmt.Sprintf("✅ Firewall '%s' applied to tag '%s'", fw.Name, tag)
+					return tea.Batch(updateFirewall(m.client, fw, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+						return c.Firewalls.AddTags(ctx, fw.ID, tag)
+					}), m.spinner.Tick)
+				})
+				return nil
+			}
+			dropletID, _ := strconv.Atoi(option.value)
+			m.loading = true
+			success := fmt.Sprintf("✅ Firewall '%s' applied to '%s'", fw.Name, m.dropletName(dropletID))
+			return tea.Batch(updateFirewall(m.client, fw, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+				return c.Firewalls.AddDroplets(ctx, fw.ID, dropletID)
+			}), m.spinner.Tick)
+		},
+	}
+}
+
+// openFirewallUnassign lets the user remove one of the firewall's droplets or tags
+func (m *model) openFirewallUnassign(fw godo.Firewall) {
+	var options []pickerOption
+	for _, id := range fw.DropletIDs {
+		options = append(options, pickerOption{label: fmt.Sprintf("Droplet: %s", m.dropletName(id)), value: strconv.Itoa(id)})
+	}
+	for _, tag := range fw.Tags {
+		options = append(options, pickerOption{label: fmt.Sprintf("Tag: %s", tag), value: "tag:" + tag})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🛡️  Remove %s from", fw.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			m.confirm = &confirmDialog{
+				title:   "Remove Firewall?",
+				lines:   []string{fmt.Sprintf("Firewall: %s", fw.Name), option.label},
+				warning: "The firewall's rules will no longer apply there.",
+				onConfirm: func(m *model) tea.Cmd {
+					m.loading = true
+					success := fmt.Sprintf("✅ Firewall '%s' removed from %s", fw.Name, option.label)
+					return tea.Batch(updateFirewall(m.client, fw, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+						if tag, ok := strings.CutPrefix(option.value, "tag:"); ok {
+							return c.Firewalls.RemoveTags(ctx, fw.ID, tag)
+						}
+						dropletID, _ := strconv.Atoi(option.value)
+						return c.Firewalls.RemoveDroplets(ctx, fw.ID, dropletID)
+					}), m.spinner.Tick)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+func containsInt(values []int, v int) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (m model) renderFirewallDetails() string {
+	fw, ok := m.selectedFirewall()
+	if !ok {
+		return ""
+	}
+	tags := strings.Join(fw.Tags, ", ")
+	if tags == "" {
+		tags = "-"
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("🛡️  Firewall: %s", fw.Name)))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("Firewall", [][2]string{
+		{"ID", fw.ID},
+		{"Status", fw.Status},
+		{"Created", fw.Created},
+		{"Pending Changes", strconv.Itoa(len(fw.PendingChanges))},
+	}))
+	b.WriteString("\n")
+
+	var inbound, outbound [][2]string
+	for _, rule := range fw.InboundRules {
+		s := rule.Sources
+		if s == nil {
+			s = &godo.Sources{}
+		}
+		inbound = append(inbound, [2]string{ruleLabel(rule.Protocol, rule.PortRange), "from " + m.formatRuleTargets(s.Addresses, s.Tags, s.DropletIDs, s.LoadBalancerUIDs, s.KubernetesIDs)})
+	}
+	for _, rule := range fw.OutboundRules {
+		d := rule.Destinations
+		if d == nil {
+			d = &godo.Destinations{}
+		}
+		outbound = append(outbound, [2]string{ruleLabel(rule.Protocol, rule.PortRange), "to " + m.formatRuleTargets(d.Addresses, d.Tags, d.DropletIDs, d.LoadBalancerUIDs, d.KubernetesIDs)})
+	}
+	b.WriteString(renderDetailsSection(fmt.Sprintf("Inbound Rules (%d)", len(inbound)), inbound))
+	b.WriteString("\n")
+	b.WriteString(renderDetailsSection(fmt.Sprintf("Outbound Rules (%d)", len(outbound)), outbound))
+	b.WriteString("\n")
+	b.WriteString(renderDetailsSection("Applies To", [][2]string{
+		{"Droplets", m.firewallDroplets(fw)},
+		{"Tags", tags},
+	}))
+	return b.String()
+}
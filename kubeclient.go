@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// kubeCredentialsRefreshMargin is how long before the API credentials expire that a new client is built
+const kubeCredentialsRefreshMargin = 5 * time.Minute
+
+// kubeClient is a Kubernetes client for one cluster
+type kubeClient struct {
+	clientset  *kubernetes.Clientset
+	restConfig *rest.Config
+	source     string    // Where the credentials came from, e.g. "kubeconfig context do-fra1-prod"
+	expiresAt  time.Time // When the API credentials expire (zero = managed by the kubeconfig)
+	kubeconfig bool      // The credentials came from the user's kubeconfig
+}
+
+// kubeClientCache holds a Kubernetes client per cluster ID, so switching
+// resources, namespaces or refreshing doesn't refetch credentials. Shared by
+// pointer between model copies and used from tea.Cmd goroutines.
+type kubeClientCache struct {
+	mu       sync.Mutex
+	clients  map[string]*kubeClient
+	rejected map[string]bool // Clusters that rejected their kubeconfig credentials
+}
+
+func newKubeClientCache() *kubeClientCache {
+	return &kubeClientCache{clients: make(map[string]*kubeClient), rejected: make(map[string]bool)}
+}
+
+// get returns the cached client for the cluster, building a new one if there
+// is none or its credentials are about to expire. A context for the cluster
+// in the user's kubeconfig is preferred over credentials from the API, until
+// the cluster rejects it.
+func (c *kubeClientCache) get(ctx context.Context, client *godo.Client, cluster *godo.KubernetesCluster) (*kubeClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if kc, ok := c.clients[cluster.ID]; ok {
+		if kc.expiresAt.IsZero() || time.Until(kc.expiresAt) > kubeCredentialsRefreshMargin {
+			return kc, nil
+		}
+	}
+
+	var kc *kubeClient
+	var err error
+	if !c.rejected[cluster.ID] {
+		kc = localKubeClient(cluster)
+	}
+	if kc == nil {
+		if kc, err = apiKubeClient(ctx, client, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	// Drop the client when the cluster rejects its credentials, so the next load builds a new one
+	kc.restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := rt.RoundTrip(req)
+			if err == nil && resp.StatusCode == http.StatusUnauthorized {
+				c.invalidate(cluster.ID, kc)
+			}
+			return resp, err
+		})
+	})
+	// An exec plugin (e.g. doctl) that can't produce credentials fails the
+	// request before it's sent, outside the wrapper above, so watch for that too
+	httpClient, err := rest.HTTPClientFor(kc.restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create k8s client: %v", err)
+	}
+	transport := httpClient.Transport
+	httpClient.Transport = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := transport.RoundTrip(req)
+		if err != nil && strings.HasPrefix(err.Error(), "getting credentials") {
+			c.invalidate(cluster.ID, kc)
+		}
+		return resp, err
+	})
+	if kc.clientset, err = kubernetes.NewForConfigAndClient(kc.restConfig, httpClient); err != nil {
+		return nil, fmt.Errorf("failed to create k8s client: %v", err)
+	}
+	c.clients[cluster.ID] = kc
+	return kc, nil
+}
+
+// invalidate drops the cluster's client after the cluster rejected its
+// credentials. Stale kubeconfig credentials won't get better by reloading, so
+// the cluster uses API credentials from then on.
+func (c *kubeClientCache) invalidate(clusterID string, kc *kubeClient) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if kc.kubeconfig {
+		c.rejected[clusterID] = true
+	}
+	// Requests still in flight on an older client mustn't drop its replacement
+	if c.clients[clusterID] == kc {
+		delete(c.clients, clusterID)
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// localKubeClient builds a client from the user's kubeconfig ($KUBECONFIG or
+// ~/.kube/config) if it has a context for the cluster, matched by API server
+// or doctl's do-<region>-<name> context name. A name match pointing at
+// another server, e.g. of a deleted cluster with the same name, is skipped.
+// Returns nil if there is none, or it can't be used.
+func localKubeClient(cluster *godo.KubernetesCluster) *kubeClient {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	config, err := loadingRules.Load()
+	if err != nil {
+		// A broken kubeconfig shouldn't stop the API credentials from working
+		return nil
+	}
+
+	doctlName := fmt.Sprintf("do-%s-%s", cluster.RegionSlug, cluster.Name)
+	contextName := ""
+	for name, kctx := range config.Contexts {
+		kcluster, ok := config.Clusters[kctx.Cluster]
+		if !ok {
+			continue
+		}
+		if cluster.Endpoint != "" {
+			if strings.TrimSuffix(kcluster.Server, "/") == strings.TrimSuffix(cluster.Endpoint, "/") {
+				contextName = name
+				break
+			}
+			continue
+		}
+		if name == doctlName {
+			contextName = name
+		}
+	}
+	if contextName == "" {
+		return nil
+	}
+
+	clientConfig := clientcmd.NewNonInteractiveClientConfig(*config, contextName, &clientcmd.ConfigOverrides{}, loadingRules)
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		// Nor should a context that doesn't resolve, e.g. a missing certificate file
+		return nil
+	}
+	return &kubeClient{restConfig: restConfig, source: "kubeconfig context " + contextName, kubeconfig: true}
+}
+
+// apiKubeClient builds a client from short-lived credentials from the DigitalOcean API
+func apiKubeClient(ctx context.Context, client *godo.Client, cluster *godo.KubernetesCluster) (*kubeClient, error) {
+	creds, _, err := client.Kubernetes.GetCredentials(ctx, cluster.ID, &godo.KubernetesClusterCredentialsGetRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster credentials: %w", err)
+	}
+	restConfig := &rest.Config{
+		Host:        creds.Server,
+		BearerToken: creds.Token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData:   creds.CertificateAuthorityData,
+			CertData: creds.ClientCertificateData,
+			KeyData:  creds.ClientKeyData,
+		},
+	}
+	return &kubeClient{restConfig: restConfig, source: "DigitalOcean API", expiresAt: creds.ExpiresAt}, nil
+}
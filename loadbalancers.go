@@ -0,0 +1,457 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+type loadBalancersLoadedMsg []godo.LoadBalancer
+
+// loadBalancerChangedMsg reports a finished load balancer change; the list is reloaded
+type loadBalancerChangedMsg string
+
+// lbHealthMsg reports the health of each backend droplet of a load balancer
+type lbHealthMsg struct {
+	lbID   string
+	health map[int]float64 // Droplet ID -> share of passing health checks, 0 to 1
+	err    error
+}
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewLoadBalancers,
+		aliases: []string{"loadbalancer", "lb", "lbs"},
+		title:   "Load Balancers",
+		load: func(m *model) tea.Cmd {
+			return loadLoadBalancers(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.projectLoadBalancers())
+		},
+		updateTable: (*model).updateLoadBalancerTable,
+		handleKey:   (*model).handleLoadBalancerKey,
+		keys: []keyHint{
+			{"a", "Add droplet"},
+			{"x", "Remove droplet"},
+			{"+", "Add rule"},
+			{"-", "Remove rule"},
+		},
+		renderDetails: model.renderLoadBalancerDetails,
+	})
+}
+
+func loadLoadBalancers(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		lbs, err := fetchLoadBalancers(context.Background(), client, reportProgress(progressChan, client, "load balancers"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return loadBalancersLoadedMsg(lbs)
+	})
+}
+
+// loadLBHealth reads the latest health check result for each backend droplet.
+// Monitoring may have no data yet (e.g. a new load balancer), in which case
+// the backends are shown as unknown.
+func loadLBHealth(client *godo.Client, lbID string) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		now := time.Now()
+		resp, _, err := client.Monitoring.GetLoadBalancerDropletsHealthChecks(context.Background(), &godo.LoadBalancerMetricsRequest{
+			LoadBalancerID: lbID,
+			Start:          now.Add(-5 * time.Minute),
+			End:            now,
+		})
+		health := map[int]float64{}
+		if err != nil {
+			return lbHealthMsg{lbID: lbID, health: health, err: err}
+		}
+		if resp == nil {
+			return lbHealthMsg{lbID: lbID, health: health}
+		}
+		for _, series := range resp.Data.Result {
+			id, err := strconv.Atoi(string(series.Metric["droplet_id"]))
+			if err != nil || len(series.Values) == 0 {
+				continue
+			}
+			// The series is the share of passing checks; the latest sample decides
+			health[id] = float64(series.Values[len(series.Values)-1].Value)
+		}
+		return lbHealthMsg{lbID: lbID, health: health}
+	})
+}
+
+// loadAllLBHealth loads backend health for every load balancer
+func loadAllLBHealth(client *godo.Client, lbs []godo.LoadBalancer) tea.Cmd {
+	cmds := make([]tea.Cmd, len(lbs))
+	for i, lb := range lbs {
+		cmds[i] = loadLBHealth(client, lb.ID)
+	}
+	return tea.Batch(cmds...)
+}
+
+// updateLoadBalancer runs a load balancer change and reports success with the message
+func updateLoadBalancer(client *godo.Client, lb godo.LoadBalancer, success string, change func(ctx context.Context, c *godo.Client) (*godo.Response, error)) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := change(context.Background(), client); err != nil {
+			return errMsg(fmt.Errorf("failed to update load balancer %s: %w", lb.Name, err))
+		}
+		return loadBalancerChangedMsg(success)
+	}
+}
+
+// selectedLoadBalancer returns the load balancer under the cursor
+func (m *model) selectedLoadBalancer() (godo.LoadBalancer, bool) {
+	loadBalancers := m.projectLoadBalancers()
+	i := m.selectedIndex(len(loadBalancers))
+	if i < 0 {
+		return godo.LoadBalancer{}, false
+	}
+	return loadBalancers[i], true
+}
+
+// lbBackends returns the droplets behind the load balancer, by ID or by tag
+func (m *model) lbBackends(lb godo.LoadBalancer) []int {
+	if lb.Tag == "" {
+		return lb.DropletIDs
+	}
+	var ids []int
+	for _, d := range m.droplets {
+		if containsString(d.Tags, lb.Tag) {
+			ids = append(ids, d.ID)
+		}
+	}
+	return ids
+}
+
+// loadBalancersForDroplet returns the load balancers the droplet is a backend of
+func (m *model) loadBalancersForDroplet(d godo.Droplet) []godo.LoadBalancer {
+	var lbs []godo.LoadBalancer
+	for _, lb := range m.loadBalancers {
+		if containsInt(lb.DropletIDs, d.ID) || (lb.Tag != "" && containsString(d.Tags, lb.Tag)) {
+			lbs = append(lbs, lb)
+		}
+	}
+	return lbs
+}
+
+func lbRegion(lb godo.LoadBalancer) string {
+	if lb.Region == nil {
+		return ""
+	}
+	return lb.Region.Slug
+}
+
+func formatForwardingRule(r godo.ForwardingRule) string {
+	rule := fmt.Sprintf("%s:%d → %s:%d", r.EntryProtocol, r.EntryPort, r.TargetProtocol, r.TargetPort)
+	if r.TlsPassthrough {
+		rule += " (passthrough)"
+	}
+	return rule
+}
+
+func formatHealthCheck(h *godo.HealthCheck) string {
+	if h == nil {
+		return "none"
+	}
+	check := fmt.Sprintf("%s:%d", h.Protocol, h.Port)
+	if h.Path != "" {
+		check += h.Path
+	}
+	return check
+}
+
+// parseForwardingRule parses "http:80:http:8080" (entry protocol:port, target protocol:port)
+func parseForwardingRule(s string) (godo.ForwardingRule, error) {
+	parts := strings.Split(strings.ReplaceAll(s, " ", ""), ":")
+	if len(parts) != 4 {
+		return godo.ForwardingRule{}, fmt.Errorf("invalid forwarding rule %q (use entry_protocol:port:target_protocol:port, e.g. http:80:http:8080)", s)
+	}
+	entryPort, err1 := strconv.Atoi(parts[1])
+	targetPort, err2 := strconv.Atoi(parts[3])
+	if err1 != nil || err2 != nil {
+		return godo.ForwardingRule{}, fmt.Errorf("invalid port in forwarding rule %q", s)
+	}
+	rule := godo.ForwardingRule{
+		EntryProtocol:  strings.ToLower(parts[0]),
+		EntryPort:      entryPort,
+		TargetProtocol: strings.ToLower(parts[2]),
+		TargetPort:     targetPort,
+	}
+	// HTTPS to HTTPS is passed through; terminating TLS needs a certificate
+	if rule.EntryProtocol == "https" || rule.EntryProtocol == "http2" {
+		if rule.TargetProtocol != rule.EntryProtocol {
+			return godo.ForwardingRule{}, fmt.Errorf("%s rules that terminate TLS need a certificate - add them in the control panel", rule.EntryProtocol)
+		}
+		rule.TlsPassthrough = true
+	}
+	return rule, nil
+}
+
+// backendHealthSummary returns e.g. "2/3 healthy", or the backend count if health isn't loaded
+func (m *model) backendHealthSummary(lb godo.LoadBalancer) string {
+	backends := m.lbBackends(lb)
+	health, ok := m.lbHealth[lb.ID]
+	if !ok || len(health) == 0 {
+		return fmt.Sprintf("%d droplets", len(backends))
+	}
+	healthy := 0
+	for _, id := range backends {
+		if share, ok := health[id]; ok && share >= 1 {
+			healthy++
+		}
+	}
+	return fmt.Sprintf("%d/%d healthy", healthy, len(backends))
+}
+
+func (m *model) updateLoadBalancerTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.18, 12},
+		{"IP", 0.13, 10},
+		{"REGION", 0.08, 6},
+		{"STATUS", 0.09, 7},
+		{"FORWARDING RULES", 0.24, 15},
+		{"HEALTH CHECK", 0.14, 10},
+		{"BACKENDS", 0.14, 10},
+	})
+
+	var rows []table.Row
+	for _, lb := range m.projectLoadBalancers() {
+		rules := make([]string, len(lb.ForwardingRules))
+		for i, r := range lb.ForwardingRules {
+			rules[i] = formatForwardingRule(r)
+		}
+		rows = append(rows, table.Row{
+			lb.Name,
+			lb.IP,
+			lbRegion(lb),
+			lb.Status,
+			strings.Join(rules, ", "),
+			formatHealthCheck(lb.HealthCheck),
+			m.backendHealthSummary(lb),
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleLoadBalancerKey(key string) (tea.Cmd, bool) {
+	var handler func(lb godo.LoadBalancer)
+	switch key {
+	case "a", "A":
+		handler = m.openLBAddDroplet
+	case "x", "X":
+		handler = m.openLBRemoveDroplet
+	case "+", "=":
+		handler = m.promptLBAddRule
+	case "-", "_":
+		handler = m.openLBRemoveRule
+	default:
+		return nil, false
+	}
+	lb, ok := m.selectedLoadBalancer()
+	if !ok {
+		return nil, true
+	}
+	m.err = nil
+	handler(lb)
+	return nil, true
+}
+
+// openLBAddDroplet offers the droplets in the load balancer's region that aren't backends yet
+func (m *model) openLBAddDroplet(lb godo.LoadBalancer) {
+	if lb.Tag != "" {
+		m.err = fmt.Errorf("%s selects droplets by tag %q - tag the droplet instead", lb.Name, lb.Tag)
+		return
+	}
+	var options []pickerOption
+	for _, d := range m.droplets {
+		if d.Region == nil || d.Region.Slug != lbRegion(lb) || containsInt(lb.DropletIDs, d.ID) {
+			continue
+		}
+		options = append(options, pickerOption{label: fmt.Sprintf("%s (%s)", d.Name, d.Status), value: strconv.Itoa(d.ID)})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("⚖️  Add a droplet to %s", lb.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			dropletID, _ := strconv.Atoi(option.value)
+			m.loading = true
+			success := fmt.Sprintf("✅ Added '%s' to load balancer '%s'", m.dropletName(dropletID), lb.Name)
+			return tea.Batch(updateLoadBalancer(m.client, lb, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+				return c.LoadBalancers.AddDroplets(ctx, lb.ID, dropletID)
+			}), m.spinner.Tick)
+		},
+	}
+}
+
+func (m *model) openLBRemoveDroplet(lb godo.LoadBalancer) {
+	if lb.Tag != "" {
+		m.err = fmt.Errorf("%s selects droplets by tag %q - untag the droplet instead", lb.Name, lb.Tag)
+		return
+	}
+	var options []pickerOption
+	for _, id := range lb.DropletIDs {
+		options = append(options, pickerOption{label: m.dropletName(id), value: strconv.Itoa(id)})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("⚖️  Remove a droplet from %s", lb.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			dropletID, _ := strconv.Atoi(option.value)
+			m.confirm = &confirmDialog{
+				title:   "Remove Droplet from Load Balancer?",
+				lines:   []string{fmt.Sprintf("Load balancer: %s", lb.Name), fmt.Sprintf("Droplet: %s", option.label)},
+				warning: "The droplet will stop receiving traffic from the load balancer.",
+				onConfirm: func(m *model) tea.Cmd {
+					m.loading = true
+					success := fmt.Sprintf("✅ Removed '%s' from load balancer '%s'", option.label, lb.Name)
+					return tea.Batch(updateLoadBalancer(m.client, lb, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+						return c.LoadBalancers.RemoveDroplets(ctx, lb.ID, dropletID)
+					}), m.spinner.Tick)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+func (m *model) promptLBAddRule(lb godo.LoadBalancer) {
+	m.input = newInputDialog(fmt.Sprintf("⚖️  Add forwarding rule to %s (entry:port:target:port)", lb.Name), "http:80:http:8080", "", func(m *model, value string) tea.Cmd {
+		rule, err := parseForwardingRule(value)
+		if err != nil {
+			m.err = err
+			return nil
+		}
+		m.loading = true
+		success := fmt.Sprintf("✅ Added rule %s to '%s'", formatForwardingRule(rule), lb.Name)
+		return tea.Batch(updateLoadBalancer(m.client, lb, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+			return c.LoadBalancers.AddForwardingRules(ctx, lb.ID, rule)
+		}), m.spinner.Tick)
+	})
+}
+
+func (m *model) openLBRemoveRule(lb godo.LoadBalancer) {
+	if len(lb.ForwardingRules) <= 1 {
+		m.err = fmt.Errorf("%s needs at least one forwarding rule - add another before removing this one", lb.Name)
+		return
+	}
+	options := make([]pickerOption, len(lb.ForwardingRules))
+	for i, r := range lb.ForwardingRules {
+		options[i] = pickerOption{label: formatForwardingRule(r), value: strconv.Itoa(i)}
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("⚖️  Remove forwarding rule from %s", lb.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			i, _ := strconv.Atoi(option.value)
+			rule := lb.ForwardingRules[i]
+			m.confirm = &confirmDialog{
+				title:   "Remove Forwarding Rule?",
+				lines:   []string{fmt.Sprintf("Load balancer: %s", lb.Name), fmt.Sprintf("Rule: %s", option.label)},
+				warning: "Traffic on this entry port will no longer be forwarded.",
+				onConfirm: func(m *model) tea.Cmd {
+					m.loading = true
+					success := fmt.Sprintf("✅ Removed rule %s from '%s'", option.label, lb.Name)
+					return tea.Batch(updateLoadBalancer(m.client, lb, success, func(ctx context.Context, c *godo.Client) (*godo.Response, error) {
+						return c.LoadBalancers.RemoveForwardingRules(ctx, lb.ID, rule)
+					}), m.spinner.Tick)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+// showDropletLoadBalancers switches to the load balancers view with the
+// droplet's first load balancer selected
+func (m *model) showDropletLoadBalancers(d godo.Droplet) tea.Cmd {
+	lbs := m.loadBalancersForDroplet(d)
+	if len(lbs) == 0 {
+		return nil
+	}
+	m.viewingDetails = false
+	m.selectedDroplet = nil
+	cmd := m.switchToResourceView(resourceViews[viewLoadBalancers])
+	for i, lb := range m.projectLoadBalancers() {
+		if lb.ID == lbs[0].ID {
+			m.table.SetCursor(i)
+		}
+	}
+	return cmd
+}
+
+func (m model) renderLoadBalancerDetails() string {
+	lb, ok := m.selectedLoadBalancer()
+	if !ok {
+		return ""
+	}
+	size := lb.SizeSlug
+	if size == "" {
+		size = fmt.Sprintf("%d nodes", lb.SizeUnit)
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("⚖️  Load Balancer: %s", lb.Name)))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("Load Balancer", [][2]string{
+		{"ID", lb.ID},
+		{"IP", lb.IP},
+		{"Region", lbRegion(lb)},
+		{"Status", lb.Status},
+		{"Size", size},
+		{"Algorithm", lb.Algorithm},
+		{"Redirect HTTP→HTTPS", strconv.FormatBool(lb.RedirectHttpToHttps)},
+		{"Created", lb.Created},
+	}))
+	b.WriteString("\n")
+
+	var rules [][2]string
+	for i, r := range lb.ForwardingRules {
+		rules = append(rules, [2]string{strconv.Itoa(i + 1), formatForwardingRule(r)})
+	}
+	b.WriteString(renderDetailsSection("Forwarding Rules", rules))
+	b.WriteString("\n")
+
+	if h := lb.HealthCheck; h != nil {
+		b.WriteString(renderDetailsSection("Health Check", [][2]string{
+			{"Target", formatHealthCheck(h)},
+			{"Interval", fmt.Sprintf("%ds", h.CheckIntervalSeconds)},
+			{"Timeout", fmt.Sprintf("%ds", h.ResponseTimeoutSeconds)},
+			{"Healthy Threshold", strconv.Itoa(h.HealthyThreshold)},
+			{"Unhealthy Threshold", strconv.Itoa(h.UnhealthyThreshold)},
+		}))
+		b.WriteString("\n")
+	}
+
+	title := "Backends"
+	if lb.Tag != "" {
+		title = fmt.Sprintf("Backends (tag: %s)", lb.Tag)
+	}
+	var backends [][2]string
+	health := m.lbHealth[lb.ID]
+	for _, id := range m.lbBackends(lb) {
+		status := "unknown"
+		if err := m.lbHealthErrs[lb.ID]; err != nil {
+			status = fmt.Sprintf("unknown (%v)", err)
+		} else if share, ok := health[id]; ok {
+			switch {
+			case share >= 1:
+				status = "● healthy"
+			case share > 0:
+				status = fmt.Sprintf("◐ failing %.0f%% of checks", (1-share)*100)
+			default:
+				status = "○ unhealthy"
+			}
+		}
+		backends = append(backends, [2]string{m.dropletName(id), status})
+	}
+	b.WriteString(renderDetailsSection(title, backends))
+	return b.String()
+}
@@ -0,0 +1,462 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// logRefreshInterval is how often a following log pane reloads its logs
+const logRefreshInterval = 3 * time.Second
+
+// maxLogLines caps the lines kept in a log pane
+const maxLogLines = 5000
+
+// maxLogStreamBatch caps the lines a streaming pane takes per redraw
+const maxLogStreamBatch = 500
+
+var logMatchStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("0")).Background(warningColor)
+
+// logPane is a full-screen, scrollable view of log lines. Lines either come
+// from fetch, reloaded every logRefreshInterval while following, or from
+// stream, appended as they arrive. While following, the newest lines stay in view.
+type logPane struct {
+	title  string
+	lines  []string
+	err    error
+	loaded bool
+	follow bool                                        // Keep the newest lines in view and keep reloading
+	scroll int                                         // First visible line when not following
+	fetch  func(ctx context.Context) ([]string, error) // Loads all lines, called off the UI goroutine
+	id     int64                                       // Identifies the pane's messages, so a closed pane's ticks are dropped
+	ticked bool                                        // A reload tick is scheduled
+
+	stream      func(ctx context.Context, emit func(line string)) error // Streams lines until ctx is done (replaces fetch)
+	streaming   bool                                                    // The stream is running
+	streamLines chan string
+	streamErr   chan error
+	cancel      context.CancelFunc // Stops the stream
+
+	search string // Highlighted text, case-insensitive ("" = none)
+	notice string // One-line status, e.g. where the buffer was saved
+
+	// Optional pane-specific keys, status and help, e.g. the pod log toggles
+	handleKey func(m *model, key string) (tea.Cmd, bool)
+	status    func() string
+	help      string
+}
+
+// logPaneLoadedMsg carries freshly fetched lines for the pane with the id
+type logPaneLoadedMsg struct {
+	id    int64
+	lines []string
+	err   error
+}
+
+// logPaneTickMsg asks the pane with the id to reload
+type logPaneTickMsg int64
+
+// logPaneStreamMsg carries lines streamed to the pane with the id
+type logPaneStreamMsg struct {
+	id    int64
+	lines []string
+	done  bool  // The stream ended
+	err   error // Why the stream ended, nil if it ended normally
+}
+
+// openLogPane shows a log pane and starts loading it
+func (m *model) openLogPane(title string, fetch func(ctx context.Context) ([]string, error)) tea.Cmd {
+	m.closeLogPane()
+	m.logPane = &logPane{
+		title:  title,
+		follow: true,
+		fetch:  fetch,
+		id:     time.Now().UnixNano(),
+	}
+	return fetchLogPane(m.logPane)
+}
+
+// openLogStream shows a log pane and starts streaming into it
+func (m *model) openLogStream(title string, stream func(ctx context.Context, emit func(line string)) error) tea.Cmd {
+	m.closeLogPane()
+	m.logPane = &logPane{
+		title:  title,
+		follow: true,
+		stream: stream,
+	}
+	return startLogStream(m.logPane)
+}
+
+// closeLogPane hides the log pane, stopping its stream
+func (m *model) closeLogPane() {
+	if m.logPane != nil && m.logPane.cancel != nil {
+		m.logPane.cancel()
+	}
+	m.logPane = nil
+}
+
+func fetchLogPane(p *logPane) tea.Cmd {
+	id, fetch := p.id, p.fetch
+	return func() tea.Msg {
+		lines, err := fetch(context.Background())
+		return logPaneLoadedMsg{id: id, lines: lines, err: err}
+	}
+}
+
+// startLogStream (re)starts the pane's stream from an empty buffer
+func startLogStream(p *logPane) tea.Cmd {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+	p.id = time.Now().UnixNano()
+	p.lines = nil
+	p.err = nil
+	p.loaded = false
+	p.streaming = true
+	p.streamLines = make(chan string, maxLogStreamBatch)
+	p.streamErr = make(chan error, 1)
+
+	stream, lines, errc := p.stream, p.streamLines, p.streamErr
+	go func() {
+		errc <- stream(ctx, func(line string) {
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+			}
+		})
+		close(lines)
+	}()
+	return waitForLogStream(p)
+}
+
+// waitForLogStream waits for the next lines, taking whatever else is
+// already buffered so a burst is drawn once
+func waitForLogStream(p *logPane) tea.Cmd {
+	id, lines, errc := p.id, p.streamLines, p.streamErr
+	return func() tea.Msg {
+		line, ok := <-lines
+		if !ok {
+			return logPaneStreamMsg{id: id, done: true, err: <-errc}
+		}
+		batch := []string{line}
+	drain:
+		for len(batch) < maxLogStreamBatch {
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					// Closed: the next wait reports why
+					break drain
+				}
+				batch = append(batch, line)
+			default:
+				break drain
+			}
+		}
+		return logPaneStreamMsg{id: id, lines: batch}
+	}
+}
+
+func scheduleLogPaneTick(id int64) tea.Cmd {
+	return tea.Tick(logRefreshInterval, func(time.Time) tea.Msg {
+		return logPaneTickMsg(id)
+	})
+}
+
+// updateLogPaneMsg handles the pane's load, tick and stream messages
+func (m *model) updateLogPaneMsg(msg tea.Msg) tea.Cmd {
+	p := m.logPane
+	switch msg := msg.(type) {
+	case logPaneLoadedMsg:
+		if p == nil || p.id != msg.id {
+			return nil
+		}
+		p.loaded = true
+		p.err = msg.err
+		if msg.err == nil {
+			p.lines = msg.lines
+			if len(p.lines) > maxLogLines {
+				p.lines = p.lines[len(p.lines)-maxLogLines:]
+			}
+		}
+		// Manual reloads don't start a second tick chain
+		if p.follow && !p.ticked {
+			p.ticked = true
+			return scheduleLogPaneTick(p.id)
+		}
+	case logPaneTickMsg:
+		if p == nil || p.id != int64(msg) {
+			return nil
+		}
+		p.ticked = false
+		if p.follow {
+			return fetchLogPane(p)
+		}
+	case logPaneStreamMsg:
+		if p == nil || p.id != msg.id {
+			return nil
+		}
+		p.loaded = true
+		p.lines = append(p.lines, msg.lines...)
+		if over := len(p.lines) - maxLogLines; over > 0 {
+			p.lines = p.lines[over:]
+			p.scroll = max(0, p.scroll-over)
+		}
+		if msg.done {
+			p.streaming = false
+			p.err = msg.err
+			return nil
+		}
+		return waitForLogStream(p)
+	}
+	return nil
+}
+
+// logPaneHeight is the number of log lines that fit on screen
+func (m model) logPaneHeight() int {
+	return max(5, m.height-getTopPadding()-4)
+}
+
+// reload fetches the logs again, or restarts the stream
+func (p *logPane) reload() tea.Cmd {
+	if p.stream != nil {
+		return startLogStream(p)
+	}
+	return fetchLogPane(p)
+}
+
+// findMatch returns the next line from the one after from (or the previous
+// one, searching backwards) containing the search text, wrapping around; -1 if none
+func (p *logPane) findMatch(from int, backwards bool) int {
+	if p.search == "" || len(p.lines) == 0 {
+		return -1
+	}
+	term := strings.ToLower(p.search)
+	n := len(p.lines)
+	for i := 1; i <= n; i++ {
+		j := (from + i) % n
+		if backwards {
+			j = ((from-i)%n + n) % n
+		}
+		if strings.Contains(strings.ToLower(p.lines[j]), term) {
+			return j
+		}
+	}
+	return -1
+}
+
+// countMatches returns the number of lines containing the search text
+func (p *logPane) countMatches() int {
+	if p.search == "" {
+		return 0
+	}
+	term := strings.ToLower(p.search)
+	count := 0
+	for _, line := range p.lines {
+		if strings.Contains(strings.ToLower(line), term) {
+			count++
+		}
+	}
+	return count
+}
+
+// jumpToMatch scrolls so the next (or previous) match is the top line
+func (p *logPane) jumpToMatch(backwards bool) {
+	if i := p.findMatch(p.scroll, backwards); i >= 0 {
+		p.follow = false
+		p.scroll = i
+		p.notice = ""
+	} else if p.search != "" {
+		p.notice = fmt.Sprintf("No lines match %q", p.search)
+	}
+}
+
+// save writes the buffer to a file
+func (p *logPane) save(path string) {
+	path = expandHome(path)
+	data := strings.Join(p.lines, "\n")
+	if len(p.lines) > 0 {
+		data += "\n"
+	}
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		p.notice = fmt.Sprintf("❌ Save failed: %v", err)
+		return
+	}
+	p.notice = fmt.Sprintf("✅ Saved %d lines to %s", len(p.lines), path)
+}
+
+var logFileNameInvalid = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// defaultSaveName suggests a file name from the pane title
+func (p *logPane) defaultSaveName() string {
+	name := strings.Trim(logFileNameInvalid.ReplaceAllString(p.title, "-"), "-")
+	if name == "" {
+		name = "logs"
+	}
+	return fmt.Sprintf("%s-%s.log", name, time.Now().Format("20060102-150405"))
+}
+
+func (m model) updateLogPane(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	p := m.logPane
+	maxScroll := max(0, len(p.lines)-m.logPaneHeight())
+	if p.follow {
+		p.scroll = maxScroll
+	}
+	key := msg.String()
+	if p.handleKey != nil {
+		if cmd, handled := p.handleKey(&m, key); handled {
+			return m, cmd
+		}
+	}
+	switch key {
+	case "esc", "q":
+		// Clear the search first, then close
+		if key == "esc" && p.search != "" {
+			p.search = ""
+			p.notice = ""
+			return m, nil
+		}
+		m.closeLogPane()
+		return m, nil
+	case "up", "k":
+		p.follow = false
+		p.scroll = max(0, p.scroll-1)
+	case "down", "j":
+		p.scroll = min(maxScroll, p.scroll+1)
+	case "pgup", "ctrl+b":
+		p.follow = false
+		p.scroll = max(0, p.scroll-m.logPaneHeight())
+	case "pgdown", "ctrl+f":
+		p.scroll = min(maxScroll, p.scroll+m.logPaneHeight())
+	case "home", "g":
+		p.follow = false
+		p.scroll = 0
+	case "end", "G":
+		p.scroll = maxScroll
+	case "f", "F":
+		p.follow = !p.follow
+		if p.stream != nil {
+			// Following a stream that ended starts it again; unfollowing a live one stops it
+			if p.follow && !p.streaming {
+				return m, startLogStream(p)
+			}
+			if !p.follow && p.streaming && p.cancel != nil {
+				p.cancel()
+			}
+			return m, nil
+		}
+		// Resume following, reloading right away
+		if p.follow {
+			return m, fetchLogPane(p)
+		}
+	case "r", "R":
+		return m, p.reload()
+	case "/":
+		m.input = newInputDialog("🔍 Search logs", "text to highlight", p.search, func(m *model, term string) tea.Cmd {
+			p.search = term
+			p.jumpToMatch(false)
+			return nil
+		})
+	case "n":
+		p.jumpToMatch(false)
+	case "N":
+		p.jumpToMatch(true)
+	case "s", "S":
+		m.input = newInputDialog("💾 Save logs to file", "e.g. ~/app.log", p.defaultSaveName(), func(m *model, path string) tea.Cmd {
+			p.save(path)
+			return nil
+		})
+	}
+	return m, nil
+}
+
+// highlightMatches marks every case-insensitive occurrence of term in line
+func highlightMatches(line, term string) string {
+	if term == "" {
+		return line
+	}
+	lower, lowerTerm := strings.ToLower(line), strings.ToLower(term)
+	if len(lower) != len(line) || len(lowerTerm) != len(term) {
+		// Lowercasing changed byte offsets, so match case-sensitively
+		lower, lowerTerm = line, term
+	}
+	var b strings.Builder
+	for {
+		i := strings.Index(lower, lowerTerm)
+		if i < 0 {
+			b.WriteString(line)
+			return b.String()
+		}
+		b.WriteString(line[:i])
+		b.WriteString(logMatchStyle.Render(line[i : i+len(term)]))
+		line, lower = line[i+len(term):], lower[i+len(term):]
+	}
+}
+
+func (m model) renderLogPane() string {
+	p := m.logPane
+	height := m.logPaneHeight()
+	maxScroll := max(0, len(p.lines)-height)
+	scroll := min(p.scroll, maxScroll)
+	if p.follow {
+		scroll = maxScroll
+	}
+
+	status := "paused"
+	switch {
+	case p.stream != nil && p.streaming && p.follow:
+		status = "streaming, following"
+	case p.stream != nil && p.streaming:
+		status = "streaming, scrolled"
+	case p.stream != nil:
+		status = "stream ended"
+	case p.follow:
+		status = fmt.Sprintf("following, every %s", logRefreshInterval)
+	}
+	if p.status != nil {
+		status += ", " + p.status()
+	}
+	if p.search != "" {
+		status += fmt.Sprintf(", %d lines match %q", p.countMatches(), p.search)
+	}
+
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(headerStyle.Render(p.title))
+	s.WriteString(helpStyle.Render(fmt.Sprintf("  %d lines (%s)", len(p.lines), status)))
+	s.WriteString("\n")
+	switch {
+	case p.err != nil:
+		s.WriteString(errorMessageStyle.Render(fmt.Sprintf("Error: %v", p.err)))
+		s.WriteString("\n")
+	case !p.loaded:
+		s.WriteString(m.spinner.View() + " Loading logs...\n")
+	case len(p.lines) == 0:
+		s.WriteString(helpStyle.Render("No log lines yet"))
+		s.WriteString("\n")
+	}
+
+	end := min(scroll+height, len(p.lines))
+	for _, line := range p.lines[scroll:end] {
+		line = truncateString(strings.ReplaceAll(line, "\t", "    "), m.width-1)
+		s.WriteString(highlightMatches(line, p.search))
+		s.WriteString("\n")
+	}
+	if p.notice != "" {
+		s.WriteString(p.notice)
+		s.WriteString("\n")
+	}
+	help := "[↑/↓] Scroll  [g/G] Top/Bottom  [f] Follow  [r] Reload  [/] Search  [n/N] Next/Prev  [s] Save  [esc] Close"
+	if p.help != "" {
+		help = p.help + "  " + help
+	}
+	s.WriteString(helpStyle.Render(help))
+	return s.String()
+}
@@ -2,28 +2,26 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"os"
 	"os/exec"
+	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/cliofy/govte"
-	"github.com/cliofy/govte/terminal"
 	"github.com/creack/pty/v2"
 	"github.com/digitalocean/godo"
 	"golang.org/x/oauth2"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
 )
 
 type TokenSource struct {
@@ -41,7 +39,10 @@ type model struct {
 	client              *godo.Client
 	droplets            []godo.Droplet
 	clusters            []*godo.KubernetesCluster
-	clusterResources    []map[string]interface{} // Resources from selected cluster
+	clusterResources    []map[string]interface{}         // Resources from selected cluster
+	clusterWatch        *clusterWatch                    // Live watch feeding clusterResources (nil = none)
+	clusterChanges      map[string]clusterResourceChange // Recently added/modified rows, by clusterResourceKey
+	clusterInspector    *clusterInspector                // Describe/YAML screen for a cluster resource (nil = hidden)
 	account             *godo.Account
 	creating            bool
 	viewingDetails      bool
@@ -52,6 +53,7 @@ type model struct {
 	spinner             spinner.Model
 	selectedDroplet     *godo.Droplet
 	selectedCluster     *godo.KubernetesCluster
+	kubeClients         *kubeClientCache
 	currentView         string // "droplets", "clusters", or "cluster-resources"
 	clusterResourceType string // "deployments", "pods", "services", "nodes", etc.
 	selectedNamespace   string // Current namespace filter (empty = all namespaces)
@@ -78,13 +80,21 @@ type model struct {
 	selectingRegion    bool // When true, show region selection table
 	selectingSize      bool // When true, show size selection table
 	selectingImage     bool // When true, show image selection table
+	selectingVPC       bool // When true, show VPC selection table
+	selectingSSHKeys   bool // When true, show SSH key multi-select table
 	availableRegions   []godo.Region
 	availableSizes     []godo.Size
 	availableImages    []godo.Image
-	selectedRegionSlug string      // Selected region slug for creation
-	selectedSizeSlug   string      // Selected size slug for creation
-	selectedImageSlug  string      // Selected image slug for creation
-	selectionTable     table.Model // Table for selecting region/size/image
+	selectedRegionSlug string          // Selected region slug for creation
+	selectedSizeSlug   string          // Selected size slug for creation
+	selectedImageSlug  string          // Selected image slug for creation
+	selectedVPCUUID    string          // Selected VPC for creation ("" = the region's default VPC)
+	selectedSSHKeys    []string        // SSH keys for creation, by ID, fingerprint or name (config defaults may be names)
+	userData           string          // cloud-init user data for creation ("" = none)
+	userDataSource     string          // Where the user data came from: a file name, "template <name>" or "inline"
+	userDataEditor     *textarea.Model // Inline user data editor (nil = closed)
+	userDataDroplets   map[int]string  // User data source of droplets created this session, by droplet ID
+	selectionTable     table.Model     // Table for selecting region/size/image/VPC
 	// Billing dashboard state
 	billingBalance        *godo.Balance
 	billingInvoices       []godo.InvoiceListItem
@@ -96,31 +106,81 @@ type model struct {
 	selectedBillingEntry  *godo.BillingHistoryEntry // Selected billing entry for details
 	detailedInvoice       *godo.Invoice             // Full invoice details loaded from API
 	billingDetailsScroll  int                       // Scroll position for billing details view
-	// SSH terminal state
-	sshTerminalActive      bool                     // When true, show SSH terminal view
-	sshTerminalRawOutput   *strings.Builder         // Raw terminal output buffer (for debugging only, not used for display)
-	sshTerminalEmulator    *terminal.TerminalBuffer // Terminal emulator - SINGLE SOURCE OF TRUTH for rendering
-	sshTerminalParser      *govte.Parser            // Parser for ANSI escape sequences
-	sshTerminalPTY         *os.File                 // PTY file for SSH connection
-	sshTerminalCmd         *exec.Cmd                // SSH command process
-	sshTerminalHost        string                   // Connected host name
-	sshTerminalIP          string                   // Connected IP address
-	sshTerminalMutex       sync.Mutex               // Mutex for thread-safe terminal output access
-	sshOutputChan          chan tea.Msg             // Channel for SSH output messages
-	sshTerminalConfirmExit bool                     // When true, show exit confirmation dialog
+	// Terminal state (SSH and pod shells)
+	terminal           *terminalSession // Full-screen terminal (nil = hidden)
+	terminalOutputChan chan tea.Msg     // Channel for terminal output messages
+	// Pagination progress state
+	pageProgressChan chan tea.Msg               // Channel for page-by-page load progress
+	loadProgress     map[string]loadProgressMsg // Latest progress per resource, while loading
+	// Dialog and droplet action state
+	menu          *menuDialog    // Action menu overlay (nil = hidden)
+	confirm       *confirmDialog // Yes/no confirmation overlay (nil = hidden)
+	runningAction *trackedAction // Droplet action being run and polled (nil = none)
+	resizing      *resizeState   // Droplet resize flow (nil = not resizing)
+	input         *inputDialog   // Text input overlay (nil = hidden)
+	picker        *pickerDialog  // Picker overlay (nil = hidden)
+	logPane       *logPane       // Full-screen log viewer (nil = hidden)
+	// Resource view state (views opened from command mode, see views.go)
+	viewingResourceDetails bool                              // When true, show details for the selected row
+	detailsScroll          int                               // Scroll position for the resource details screen
+	snapshots              []godo.Snapshot                   // Droplet snapshots
+	volumes                []godo.Volume                     // Block storage volumes
+	pendingVolumeCreate    bool                              // Open the volume create flow once regions are loaded
+	firewalls              []godo.Firewall                   // Cloud firewalls (nil = not loaded)
+	firewallsErr           error                             // Set if loading the firewalls failed
+	publicIP               string                            // The user's public IP, for the droplet SSH check
+	publicIPErr            error                             // Set if the public IP lookup failed
+	loadBalancers          []godo.LoadBalancer               // Load balancers
+	lbHealth               map[string]map[int]float64        // Share of passing backend health checks per load balancer ID
+	lbHealthErrs           map[string]error                  // Why a load balancer's backend health couldn't be loaded
+	vpcs                   []*godo.VPC                       // VPCs
+	vpcMembers             map[string][]*godo.VPCMember      // Members per VPC ID
+	domains                []godo.Domain                     // DNS domains
+	selectedDomain         string                            // Domain whose records are shown ("" = domain list)
+	domainRecords          []godo.DomainRecord               // Records of the selected domain
+	pendingDNSDroplet      *godo.Droplet                     // Open the DNS record flow for the droplet once domains are loaded
+	databases              []godo.Database                   // Managed database clusters
+	databaseDetails        map[string]*databaseDetails       // Users, databases, pools and trusted sources per cluster ID
+	showDBPasswords        bool                              // When true, database passwords are shown instead of masked
+	spacesBuckets          []spacesBucket                    // Spaces buckets across regions
+	spacesBucket           *spacesBucket                     // Bucket being browsed (nil = bucket list)
+	spacesPrefix           string                            // Prefix ("folder") being browsed, e.g. "backups/"
+	spacesEntries          []spacesEntry                     // Prefixes and objects under spacesPrefix
+	apps                   []*godo.App                       // App Platform apps
+	selectedApp            *godo.App                         // App being browsed (nil = app list)
+	appResourceType        string                            // "deployments" or "components" for the selected app
+	appDeployments         []*godo.Deployment                // Deployment history of the selected app, newest first
+	registry               *godo.Registry                    // The account's container registry
+	registryRepos          []*godo.RepositoryV2              // Repositories in the registry
+	registryGCs            []*godo.GarbageCollection         // Running and past garbage collections, newest first
+	registryResourceType   string                            // "repositories", "garbage-collections", "tags" or "manifests"
+	registryRepo           string                            // Repository whose tags or manifests are shown ("" = repository list)
+	registryTags           []*godo.RepositoryTag             // Tags of the selected repository
+	registryManifests      []*godo.RepositoryManifest        // Manifests of the selected repository
+	projects               []godo.Project                    // Projects (nil = not loaded)
+	projectResources       map[string][]godo.ProjectResource // Resources per project ID
+	activeProject          *godo.Project                     // Project every view is filtered to (nil = all projects)
+	projectURNs            map[string]bool                   // URNs of the active project's resources
+	pendingProjectMove     *projectMove                      // Open the move-to-project picker once projects are loaded
+	sshKeys                []godo.Key                        // Account SSH keys, for the keys view and the create form
 	// Droplet metrics state
 	dropletMetrics          *DropletMetrics         // Current droplet metrics (CPU, memory, network)
 	loadingMetrics          bool                     // When true, metrics are being loaded
+	// Config state
+	config        *Config  // Droplet template and app settings from config.yaml
+	contextName   string   // Name of the active DigitalOcean context
+	activeContext *Context // Active context settings (default region, project)
+	tokenSource   string   // Where the active API token came from
+	authFailed    bool     // When true, show the invalid/expired token screen
 }
 
 type errMsg error
 type dropletsLoadedMsg []godo.Droplet
 type clustersLoadedMsg []*godo.KubernetesCluster
-type clusterResourcesLoadedMsg struct {
-	resourceType string
-	resources    []map[string]interface{}
+type dropletCreatedMsg struct {
+	droplet *godo.Droplet
+	warning error // Set when the droplet was created but not moved to its project
 }
-type dropletCreatedMsg *godo.Droplet
 type dropletDeletedMsg struct{}
 type accountInfoMsg struct {
 	account *godo.Account
@@ -132,7 +192,6 @@ type balanceLoadedMsg *godo.Balance
 type invoicesLoadedMsg []godo.InvoiceListItem
 type billingHistoryLoadedMsg *godo.BillingHistory
 type invoiceDetailsLoadedMsg *godo.Invoice
-type sshTerminalOutputMsg string // New line of output from SSH terminal
 
 // DropletMetrics holds the current usage metrics for a droplet
 type DropletMetrics struct {
@@ -145,12 +204,38 @@ type DropletMetrics struct {
 	LastUpdated     time.Time
 }
 type dropletMetricsLoadedMsg *DropletMetrics
+type refreshTickMsg struct{} // Sent every settings.refresh_interval to auto-refresh the current view
 
 const (
 	viewDroplets         = "droplets"
 	viewClusters         = "clusters"
 	viewClusterResources = "cluster-resources"
 	viewBilling          = "billing"
+	viewSnapshots        = "snapshots"
+	viewVolumes          = "volumes"
+	viewFirewalls        = "firewalls"
+	viewLoadBalancers    = "loadbalancers"
+	viewVPCs             = "vpcs"
+	viewDomains          = "domains"
+	viewDatabases        = "databases"
+	viewSpaces           = "spaces"
+	viewApps             = "apps"
+	viewRegistry         = "registry"
+	viewProjects         = "projects"
+	viewSSHKeys          = "sshkeys"
+)
+
+// Create form fields, in tab order
+const (
+	fieldName = iota
+	fieldRegion
+	fieldSize
+	fieldImage
+	fieldVPC
+	fieldSSHKeys
+	fieldUserData
+	fieldTags
+	createFormFields // Number of fields
 )
 
 // getTopPadding returns the number of rows to reserve at the top to avoid row 0
@@ -163,6 +248,11 @@ func getTopPadding() int {
 		}
 	}
 
+	// Then the config file setting
+	if configTopPadding != nil {
+		return *configTopPadding
+	}
+
 	// Check terminal type
 	termProgram := os.Getenv("TERM_PROGRAM")
 	if termProgram == "iTerm.app" {
@@ -220,7 +310,14 @@ var (
 				Bold(true)
 )
 
-func initialModel(client *godo.Client) model {
+func initialModel(client *godo.Client, cfg *Config, cfgErr error, contextName string, activeContext *Context) model {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	if activeContext == nil {
+		activeContext = &Context{}
+	}
+
 	// Initial columns - will be recalculated on resize
 	columns := []table.Column{
 		{Title: "NAME", Width: 25},
@@ -298,7 +395,7 @@ func initialModel(client *godo.Client) model {
 	sp.Spinner = spinner.Dot
 	sp.Style = lipgloss.NewStyle().Foreground(primaryColor)
 
-	return model{
+	m := model{
 		table:               t,
 		client:              client,
 		droplets:            []godo.Droplet{},
@@ -312,7 +409,7 @@ func initialModel(client *godo.Client) model {
 		spinner:             sp,
 		selectedDroplet:     nil,
 		selectedCluster:     nil,
-		currentView:         viewDroplets,  // Start with droplets view
+		currentView:         cfg.defaultView(), // Start with the configured view (droplets by default)
 		clusterResourceType: "deployments", // Default resource type when entering cluster
 		selectedNamespace:   "",            // Empty = all namespaces
 		commandMode:         false,
@@ -360,42 +457,82 @@ func initialModel(client *godo.Client) model {
 			selTable.SetStyles(selStyles)
 			return selTable
 		}(),
-		billingBalance:         nil,
-		billingInvoices:        []godo.InvoiceListItem{},
-		billingHistory:         nil,
-		billingMode:            "invoices", // Default to invoices view
-		selectedBillingMonth:   "",
-		viewingBillingDetails:  false,
-		selectedInvoice:        nil,
-		selectedBillingEntry:   nil,
-		detailedInvoice:        nil,
-		billingDetailsScroll:   0,
-		sshTerminalActive:      false,
-		sshTerminalRawOutput:   &strings.Builder{}, // Use pointer to avoid copy issues
-		sshTerminalEmulator:    nil,
-		sshTerminalParser:      nil,
-		sshTerminalPTY:         nil,
-		sshTerminalCmd:         nil,
-		sshTerminalHost:        "",
-		sshTerminalIP:          "",
-		sshOutputChan:          make(chan tea.Msg, 100), // Buffered channel for SSH output
-		sshTerminalConfirmExit: false,                   // No confirmation dialog initially
-		dropletMetrics:          nil,                     // No metrics loaded initially
-		loadingMetrics:          false,                    // Not loading metrics initially
+		billingBalance:        nil,
+		billingInvoices:       []godo.InvoiceListItem{},
+		billingHistory:        nil,
+		billingMode:           "invoices", // Default to invoices view
+		selectedBillingMonth:  "",
+		viewingBillingDetails: false,
+		selectedInvoice:       nil,
+		selectedBillingEntry:  nil,
+		detailedInvoice:       nil,
+		billingDetailsScroll:  0,
+		terminalOutputChan:    make(chan tea.Msg, 100), // Buffered channel for terminal output
+		pageProgressChan:      make(chan tea.Msg, 100), // Buffered channel for load progress
+		loadProgress:          make(map[string]loadProgressMsg),
+		kubeClients:           newKubeClientCache(),
+		dropletMetrics:        nil,   // No metrics loaded initially
+		loadingMetrics:        false, // Not loading metrics initially
+		config:                cfg,
+		contextName:           contextName,
+		activeContext:         activeContext,
+	}
+
+	// Pre-fill the create form from the droplet template
+	m.applyDropletTemplate()
+
+	// Config errors are shown in the error bar instead of aborting startup
+	if cfgErr != nil {
+		m.err = cfgErr
+	}
+
+	return m
+}
+
+// applyDropletTemplate pre-fills the create form with values from config.yaml
+func (m *model) applyDropletTemplate() {
+	if m.config == nil {
+		return
 	}
+	m.nameInput.SetValue(m.config.DropletName)
+	m.selectedRegionSlug = m.defaultRegion()
+	m.selectedSizeSlug = m.config.Size
+	m.selectedImageSlug = m.config.ImageSlug
+	m.tagsInput.SetValue(strings.Join(m.config.Tags, ","))
+	m.selectedSSHKeys = append([]string(nil), m.config.SSHKeys...)
+}
+
+// scheduleRefresh returns a command that fires a refreshTickMsg after the interval
+func scheduleRefresh(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return refreshTickMsg{}
+	})
 }
 
 func (m model) Init() tea.Cmd {
 	// Set loading state to show spinner while fetching data
 	m.loading = true
-	return tea.Batch(
-		loadDroplets(m.client),
-		loadClusters(m.client),
+	cmds := []tea.Cmd{
+		loadDroplets(m.client, m.pageProgressChan),
+		loadClusters(m.client, m.pageProgressChan),
 		loadAccountInfo(m.client),
+		waitForLoadProgress(m.pageProgressChan),
 		tea.EnterAltScreen,
 		m.spinner.Tick,
 		tea.WindowSize(), // Get initial window size
-	)
+	}
+	// Billing isn't loaded up front unless it's the configured start view
+	if m.currentView == viewBilling {
+		cmds = append(cmds,
+			loadBalance(m.client),
+			loadInvoices(m.client, m.pageProgressChan),
+			loadBillingHistory(m.client, m.pageProgressChan),
+		)
+	}
+	if interval := m.config.refreshInterval(); interval > 0 {
+		cmds = append(cmds, scheduleRefresh(interval))
+	}
+	return tea.Batch(cmds...)
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -409,9 +546,41 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.updateCommandMode(msg)
 		}
 
-		// Handle SSH terminal mode - all input goes to SSH terminal emulator
-		if m.sshTerminalActive {
-			return m.updateSSHTerminal(msg)
+		// Handle terminal mode - all input goes to the terminal emulator
+		if m.terminal != nil {
+			return m.updateTerminal(msg)
+		}
+
+		// Handle invalid/expired token screen
+		if m.authFailed {
+			return m.updateAuthFailed(msg)
+		}
+
+		// Handle generic confirmation and menu overlays
+		if m.confirm != nil {
+			return m.updateConfirmDialog(msg)
+		}
+		if m.menu != nil {
+			return m.updateMenuDialog(msg)
+		}
+		if m.input != nil {
+			return m.updateInputDialog(msg)
+		}
+		if m.picker != nil {
+			return m.updatePickerDialog(msg)
+		}
+		if m.logPane != nil {
+			return m.updateLogPane(msg)
+		}
+		if m.userDataEditor != nil {
+			return m.updateUserDataEditor(msg)
+		}
+		if m.clusterInspector != nil {
+			return m.updateClusterInspector(msg)
+		}
+
+		if m.resizing != nil {
+			return m.updateResize(msg)
 		}
 
 		// Handle SSH IP selection menu
@@ -531,6 +700,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m.startSSHTerminalView(ip, d.Name)
 				}
 				return m, nil
+			case "l", "L":
+				// Jump to the load balancers the droplet is behind
+				if m.selectedDroplet != nil {
+					return m, m.showDropletLoadBalancers(*m.selectedDroplet)
+				}
+				return m, nil
 			case "esc", "enter", "backspace":
 				m.viewingDetails = false
 				m.selectedDroplet = nil
@@ -541,15 +716,22 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Resource views handle their own keys; only global keys fall through
+		if rv := m.resourceView(); rv != nil {
+			updated, cmd, handled := m.updateResourceView(rv, msg)
+			if handled {
+				return updated, cmd
+			}
+			m = updated.(model)
+		}
+
 		switch msg.String() {
 		case ":":
-			// Enter command mode (only in cluster-resources view)
-			if m.currentView == viewClusterResources {
-				m.commandMode = true
-				m.commandInput.Focus()
-				m.commandInput.SetValue("")
-				return m, nil
-			}
+			// Enter command mode (resource switching in cluster view, :ctx everywhere)
+			m.commandMode = true
+			m.commandInput.Focus()
+			m.commandInput.SetValue("")
+			return m, nil
 		case "ctrl+c", "q":
 			if m.commandMode {
 				m.commandMode = false
@@ -559,18 +741,20 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		case "1":
 			// Switch to droplets view
+			m.stopClusterWatch()
 			m.currentView = viewDroplets
 			m.loading = true
 			// Update table immediately with droplet columns
 			m.updateTableRows()
-			return m, tea.Batch(loadDroplets(m.client), m.spinner.Tick)
+			return m, tea.Batch(loadDroplets(m.client, m.pageProgressChan), m.spinner.Tick)
 		case "2":
 			// Switch to clusters view
+			m.stopClusterWatch()
 			m.currentView = viewClusters
 			m.loading = true
 			// Update table immediately with cluster columns
 			m.updateTableRows()
-			return m, tea.Batch(loadClusters(m.client), m.spinner.Tick)
+			return m, tea.Batch(loadClusters(m.client, m.pageProgressChan), m.spinner.Tick)
 		case "3":
 			// Handle "3" based on current view
 			if m.currentView == viewDroplets {
@@ -583,13 +767,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 			// In other views, "3" switches to billing dashboard
+			m.stopClusterWatch()
 			m.currentView = viewBilling
 			m.loading = true
 			m.updateTableRows()
 			return m, tea.Batch(
 				loadBalance(m.client),
-				loadInvoices(m.client),
-				loadBillingHistory(m.client),
+				loadInvoices(m.client, m.pageProgressChan),
+				loadBillingHistory(m.client, m.pageProgressChan),
 				m.spinner.Tick,
 			)
 		case "n", "N":
@@ -606,7 +791,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						}
 						// Reload current resource type with new namespace filter
 						m.loading = true
-						return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, m.clusterResourceType, m.selectedNamespace), m.spinner.Tick)
+						return m, m.watchClusterResources()
 					}
 				} else {
 					// Toggle between all namespaces and current namespace
@@ -614,17 +799,17 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						// Switch to viewing namespaces to select one
 						m.clusterResourceType = "namespaces"
 						m.loading = true
-						return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, "namespaces", ""), m.spinner.Tick)
+						return m, m.watchClusterResources()
 					} else {
 						// Clear namespace filter (show all)
 						m.selectedNamespace = ""
 						m.loading = true
-						return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, m.clusterResourceType, ""), m.spinner.Tick)
+						return m, m.watchClusterResources()
 					}
 				}
 			} else if m.currentView == viewDroplets {
 				m.creating = true
-				m.inputIndex = 0
+				m.inputIndex = fieldName
 				m.nameInput.Focus()
 				m.err = nil
 				m.successMsg = ""
@@ -632,31 +817,34 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectingRegion = false
 				m.selectingSize = false
 				m.selectingImage = false
-				m.selectedRegionSlug = ""
-				m.selectedSizeSlug = ""
-				m.selectedImageSlug = ""
-				// Load regions, sizes, and images when opening create form
+				m.selectingVPC = false
+				m.selectingSSHKeys = false
+				// Pre-fill region/size/image (and name/tags/SSH keys) from the config template
+				m.applyDropletTemplate()
+				// Load regions, sizes, images, VPCs and SSH keys when opening create form
 				return m, tea.Batch(
-					loadRegions(m.client),
-					loadSizes(m.client),
-					loadImages(m.client),
+					loadRegions(m.client, m.pageProgressChan),
+					loadSizes(m.client, m.pageProgressChan),
+					loadImages(m.client, m.pageProgressChan),
+					loadVPCs(m.client, m.pageProgressChan),
+					loadSSHKeys(m.client, m.pageProgressChan),
 				)
 			}
 		case "r", "R":
 			m.loading = true
 			if m.currentView == viewDroplets {
-				return m, tea.Batch(loadDroplets(m.client), m.spinner.Tick)
+				return m, tea.Batch(loadDroplets(m.client, m.pageProgressChan), m.spinner.Tick)
 			} else if m.currentView == viewClusterResources {
-				return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, m.clusterResourceType, m.selectedNamespace), m.spinner.Tick)
+				return m, m.watchClusterResources()
 			} else if m.currentView == viewBilling {
 				return m, tea.Batch(
 					loadBalance(m.client),
-					loadInvoices(m.client),
-					loadBillingHistory(m.client),
+					loadInvoices(m.client, m.pageProgressChan),
+					loadBillingHistory(m.client, m.pageProgressChan),
 					m.spinner.Tick,
 				)
 			} else {
-				return m, tea.Batch(loadClusters(m.client), m.spinner.Tick)
+				return m, tea.Batch(loadClusters(m.client, m.pageProgressChan), m.spinner.Tick)
 			}
 		case "d", "D":
 			// Switch resource types in cluster view, or delete in droplets view
@@ -674,7 +862,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.clusterResourceType = resourceTypes[currentIdx]
 					m.loading = true
 					m.updateTableRows()
-					return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, m.clusterResourceType, m.selectedNamespace), m.spinner.Tick)
+					return m, m.watchClusterResources()
 				}
 			} else if m.currentView == viewDroplets {
 				if m.table.SelectedRow() != nil && len(m.table.SelectedRow()) > 0 {
@@ -691,6 +879,20 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			return m, nil
+		case "a", "A":
+			// Open the power action menu for the selected droplet
+			if m.currentView == viewDroplets {
+				if m.table.SelectedRow() != nil && len(m.table.SelectedRow()) > 0 {
+					selectedName := m.table.SelectedRow()[0]
+					for _, d := range m.droplets {
+						if d.Name == selectedName {
+							m.openDropletActionMenu(d)
+							break
+						}
+					}
+				}
+			}
+			return m, nil
 		case "s", "S":
 			// SSH into selected droplet - show IP selection menu
 			if m.currentView == viewDroplets {
@@ -735,6 +937,43 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 			return m, nil
+		case "l", "L":
+			// Stream the selected pod's logs
+			if m.currentView == viewClusterResources && m.clusterResourceType == "pods" {
+				if r := m.selectedClusterResource(); r != nil {
+					return m, m.openPodLogs(r)
+				}
+			}
+			return m, nil
+		case "y", "Y":
+			// Show the selected cluster resource as YAML
+			if m.currentView == viewClusterResources && m.clusterResourceType != "namespaces" {
+				if r := m.selectedClusterResource(); r != nil {
+					return m, m.openClusterInspector(r, true)
+				}
+			}
+			return m, nil
+		case "x", "X":
+			// Open a shell in the selected pod, or ask for a command to exec with X
+			if m.currentView == viewClusterResources && m.clusterResourceType == "pods" {
+				if r := m.selectedClusterResource(); r != nil {
+					return m, m.openPodExec(r, msg.String() == "X")
+				}
+			}
+			return m, nil
+		case "w", "W":
+			// Point a DNS record at the selected droplet
+			if m.currentView == viewDroplets {
+				if m.table.SelectedRow() != nil && len(m.table.SelectedRow()) > 0 {
+					selectedName := m.table.SelectedRow()[0]
+					for _, d := range m.droplets {
+						if d.Name == selectedName {
+							return m, m.startDropletDNSRecord(d)
+						}
+					}
+				}
+			}
+			return m, nil
 		case "enter":
 			if m.table.SelectedRow() != nil && len(m.table.SelectedRow()) > 0 {
 				selectedName := m.table.SelectedRow()[0]
@@ -746,10 +985,20 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.selectedCluster = nil
 							m.dropletMetrics = nil // Reset metrics
 							m.loadingMetrics = true
-							return m, tea.Batch(
-								loadDropletMetrics(m.client, m.droplets[i].ID),
-								m.spinner.Tick,
-							)
+							cmds := []tea.Cmd{loadDropletMetrics(m.client, m.droplets[i].ID), m.spinner.Tick}
+							// Attached volumes are listed by name
+							if len(m.droplets[i].VolumeIDs) > 0 {
+								cmds = append(cmds, loadVolumes(m.client, m.pageProgressChan))
+							}
+							// Firewalls and the user's IP for the SSH check
+							m.firewallsErr = nil
+							cmds = append(cmds, loadFirewalls(m.client, m.pageProgressChan))
+							if m.publicIP == "" && m.publicIPErr == nil && m.config.publicIPLookup() {
+								cmds = append(cmds, loadPublicIP())
+							}
+							// Load balancers the droplet is behind, and its VPC
+							cmds = append(cmds, loadLoadBalancers(m.client, m.pageProgressChan), loadVPCs(m.client, m.pageProgressChan))
+							return m, tea.Batch(cmds...)
 						}
 					}
 				} else if m.currentView == viewClusters {
@@ -763,7 +1012,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.selectedNamespace = ""              // Start with all namespaces
 							m.loading = true
 							m.updateTableRows()
-							return m, tea.Batch(loadClusterResources(m.client, m.clusters[i], "deployments", ""), m.spinner.Tick)
+							return m, m.watchClusterResources()
 						}
 					}
 				} else if m.currentView == viewClusterResources && m.clusterResourceType == "namespaces" {
@@ -778,7 +1027,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.clusterResourceType = "deployments"
 					}
 					m.loading = true
-					return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, m.clusterResourceType, m.selectedNamespace), m.spinner.Tick)
+					return m, m.watchClusterResources()
+				} else if m.currentView == viewClusterResources {
+					// Describe the selected resource
+					if r := m.selectedClusterResource(); r != nil {
+						return m, m.openClusterInspector(r, false)
+					}
 				} else if m.currentView == viewBilling {
 					if m.billingMode == "monthly" && m.selectedBillingMonth == "" {
 						// Enter month to see details - convert "Jan 2024" back to "2024-01"
@@ -870,6 +1124,23 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectedBillingMonth = "" // Reset to monthly summary
 				m.updateBillingTable()
 			}
+			// Move the selected droplet or cluster to another project
+			if m.table.SelectedRow() != nil && len(m.table.SelectedRow()) > 0 {
+				selectedName := m.table.SelectedRow()[0]
+				if m.currentView == viewDroplets {
+					for _, d := range m.droplets {
+						if d.Name == selectedName {
+							return m, m.startProjectMove(d.URN(), "droplet "+d.Name)
+						}
+					}
+				} else if m.currentView == viewClusters {
+					for _, c := range m.clusters {
+						if c.Name == selectedName {
+							return m, m.startProjectMove(c.URN(), "cluster "+c.Name)
+						}
+					}
+				}
+			}
 			return m, nil
 		case "i", "I":
 			// Switch to invoices view
@@ -884,6 +1155,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.currentView == viewClusterResources {
 				m.currentView = viewClusters
 				m.selectedCluster = nil
+				m.stopClusterWatch()
 				m.updateTableRows()
 				return m, nil
 			}
@@ -897,14 +1169,31 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case spinner.TickMsg:
-		if m.loading {
+		if m.loading || m.runningAction != nil {
 			var cmd tea.Cmd
 			m.spinner, cmd = m.spinner.Update(msg)
 			cmds = append(cmds, cmd)
 		}
 
+	case dropletActionMsg:
+		return m.handleDropletAction(msg)
+
+	case clientMsg:
+		if msg.client != m.client {
+			// Started before a :ctx switch
+			return m, nil
+		}
+		return m.Update(msg.msg)
+
+	case loadProgressMsg:
+		if msg.client == m.client {
+			m.loadProgress[msg.resource] = msg
+		}
+		return m, waitForLoadProgress(m.pageProgressChan)
+
 	case dropletsLoadedMsg:
 		m.loading = false
+		delete(m.loadProgress, "droplets")
 		m.droplets = msg
 		m.dropletCount = len(msg)
 		m.lastRefresh = time.Now()
@@ -928,8 +1217,296 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.updateAllDimensions(m.width, m.height)
 		return m, tea.Batch(cmds...)
 
+	case snapshotsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "snapshots")
+		m.snapshots = msg
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case volumesLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "volumes")
+		m.volumes = msg
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case volumeChangedMsg:
+		m.loading = false
+		m.successMsg = string(msg)
+		return m, loadVolumes(m.client, m.pageProgressChan)
+
+	case firewallsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "firewalls")
+		m.firewalls = append([]godo.Firewall{}, msg...) // Non-nil marks them loaded
+		m.firewallsErr = nil
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case firewallsFailedMsg:
+		m.firewallsErr = msg.err
+		return m.Update(errMsg(msg.err))
+
+	case firewallChangedMsg:
+		m.loading = false
+		m.successMsg = string(msg)
+		return m, loadFirewalls(m.client, m.pageProgressChan)
+
+	case publicIPMsg:
+		m.publicIP = msg.ip
+		m.publicIPErr = msg.err
+		return m, nil
+
+	case loadBalancersLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "load balancers")
+		m.loadBalancers = msg
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		if m.currentView == viewLoadBalancers {
+			cmds = append(cmds, loadAllLBHealth(m.client, msg))
+		}
+		return m, tea.Batch(cmds...)
+
+	case lbHealthMsg:
+		if m.lbHealth == nil {
+			m.lbHealth = make(map[string]map[int]float64)
+			m.lbHealthErrs = make(map[string]error)
+		}
+		m.lbHealth[msg.lbID] = msg.health
+		m.lbHealthErrs[msg.lbID] = msg.err
+		m.updateTableRows()
+		return m, nil
+
+	case loadBalancerChangedMsg:
+		m.loading = false
+		m.successMsg = string(msg)
+		return m, loadLoadBalancers(m.client, m.pageProgressChan)
+
+	case vpcsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "vpcs")
+		m.vpcs = msg
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		if m.currentView == viewVPCs {
+			cmds = append(cmds, loadVPCMembers(m.client, msg))
+		}
+		return m, tea.Batch(cmds...)
+
+	case vpcMembersMsg:
+		if m.vpcMembers == nil {
+			m.vpcMembers = make(map[string][]*godo.VPCMember)
+		}
+		m.vpcMembers[msg.vpcID] = msg.members
+		m.updateTableRows()
+		return m, nil
+
+	case spacesBucketsLoadedMsg:
+		m.loading = false
+		m.spacesBuckets = msg.buckets
+		m.err = msg.err
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case spacesObjectsLoadedMsg:
+		m.loading = false
+		if m.spacesBucket != nil && msg.bucket == m.spacesBucket.name && msg.prefix == m.spacesPrefix {
+			m.spacesEntries = msg.entries
+			m.lastRefresh = time.Now()
+			m.updateTableRows()
+		}
+		return m, tea.Batch(cmds...)
+
+	case spacesObjectChangedMsg:
+		m.loading = false
+		m.successMsg = string(msg)
+		if m.currentView == viewSpaces && m.spacesBucket != nil {
+			return m, m.loadSpacesObjects(*m.spacesBucket, m.spacesPrefix)
+		}
+		return m, nil
+
+	case appsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "apps")
+		m.apps = msg
+		if m.selectedApp != nil {
+			// Keep the browsed app's active deployment and phase current
+			for _, app := range m.apps {
+				if app.ID == m.selectedApp.ID {
+					m.selectedApp = app
+					break
+				}
+			}
+		}
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case appDeploymentsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "deployments")
+		if m.selectedApp != nil && msg.appID == m.selectedApp.ID {
+			m.appDeployments = msg.deployments
+			m.lastRefresh = time.Now()
+			m.updateTableRows()
+		}
+		return m, tea.Batch(cmds...)
+
+	case appChangedMsg:
+		m.loading = false
+		m.successMsg = msg.message
+		if m.currentView == viewApps {
+			if m.selectedApp != nil && msg.appID == m.selectedApp.ID {
+				return m, tea.Batch(loadApps(m.client, m.pageProgressChan), loadAppDeployments(m.client, msg.appID, m.pageProgressChan))
+			}
+			return m, loadApps(m.client, m.pageProgressChan)
+		}
+		return m, nil
+
+	case registryLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "repositories")
+		m.registry = msg.registry
+		m.registryRepos = msg.repos
+		m.registryGCs = msg.gcs
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case registryRepoLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "tags")
+		delete(m.loadProgress, "manifests")
+		if msg.repo == m.registryRepo {
+			m.registryTags = msg.tags
+			m.registryManifests = msg.manifests
+			m.lastRefresh = time.Now()
+			m.updateTableRows()
+		}
+		return m, tea.Batch(cmds...)
+
+	case registryChangedMsg:
+		m.loading = false
+		m.successMsg = string(msg)
+		// Cluster integrations show in the clusters' registry flag
+		reload := []tea.Cmd{loadClusters(m.client, m.pageProgressChan)}
+		if m.currentView == viewRegistry {
+			reload = append(reload, m.resourceView().load(&m))
+		}
+		return m, tea.Batch(reload...)
+
+	case projectsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "projects")
+		m.projects = msg.projects
+		m.projectResources = msg.resources
+		if m.activeProject != nil {
+			if resources, ok := msg.resources[m.activeProject.ID]; ok {
+				m.projectURNs = projectURNs(resources)
+			}
+		}
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		if m.pendingProjectMove != nil {
+			move := *m.pendingProjectMove
+			m.pendingProjectMove = nil
+			m.openProjectMovePicker(move.urn, move.name)
+		}
+		return m, tea.Batch(cmds...)
+
+	case activeProjectMsg:
+		m.loading = false
+		m.successMsg = fmt.Sprintf("Showing resources of project %s (%d)", msg.project.Name, len(msg.urns))
+		return m, m.setActiveProject(msg.project, msg.urns)
+
+	case projectResourceMovedMsg:
+		m.loading = false
+		m.successMsg = msg.message
+		if m.activeProject != nil {
+			if msg.project.ID == m.activeProject.ID {
+				m.projectURNs[msg.urn] = true
+			} else {
+				delete(m.projectURNs, msg.urn)
+			}
+			m.updateTableRows()
+		}
+		return m, loadProjects(m.client, m.pageProgressChan)
+
+	case sshKeysLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "SSH keys")
+		m.sshKeys = msg
+		if m.selectingSSHKeys {
+			m.setupSelectionTable("sshkeys")
+		}
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case sshKeyChangedMsg:
+		m.loading = false
+		m.successMsg = string(msg)
+		return m, loadSSHKeys(m.client, m.pageProgressChan)
+
+	case databasesLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "databases")
+		m.databases = msg
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		return m, tea.Batch(cmds...)
+
+	case databaseDetailsMsg:
+		if m.databaseDetails == nil {
+			m.databaseDetails = make(map[string]*databaseDetails)
+		}
+		m.databaseDetails[msg.id] = msg.details
+		return m, nil
+
+	case domainsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "domains")
+		m.domains = msg
+		m.lastRefresh = time.Now()
+		m.updateTableRows()
+		if m.pendingDNSDroplet != nil {
+			d := *m.pendingDNSDroplet
+			m.pendingDNSDroplet = nil
+			m.openDropletDNSPicker(d)
+		}
+		return m, tea.Batch(cmds...)
+
+	case domainRecordsLoadedMsg:
+		m.loading = false
+		delete(m.loadProgress, "records")
+		if msg.domain == m.selectedDomain {
+			m.domainRecords = msg.records
+			m.lastRefresh = time.Now()
+			m.updateTableRows()
+		}
+		return m, tea.Batch(cmds...)
+
+	case domainRecordChangedMsg:
+		m.loading = false
+		m.successMsg = msg.message
+		if m.currentView == viewDomains && msg.domain == m.selectedDomain {
+			return m, loadDomainRecords(m.client, msg.domain, m.pageProgressChan)
+		}
+		return m, nil
+
+	case snapshotDeletedMsg:
+		m.successMsg = fmt.Sprintf("✅ Snapshot '%s' deleted successfully!", string(msg))
+		return m, loadSnapshots(m.client, m.pageProgressChan)
+
 	case clustersLoadedMsg:
 		m.loading = false
+		delete(m.loadProgress, "clusters")
 		m.clusters = msg
 		m.clusterCount = len(msg)
 		m.lastRefresh = time.Now()
@@ -941,30 +1518,165 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(cmds...)
 
 	case clusterResourcesLoadedMsg:
+		// Ignore snapshots from a watch that has since been replaced
+		if msg.watch != m.clusterWatch {
+			return m, nil
+		}
 		m.loading = false
+		// Keep the cursor on the same resource as rows come and go
+		selectedKey := ""
+		if r := m.selectedClusterResource(); r != nil {
+			selectedKey = clusterResourceKey(r)
+		}
 		m.clusterResources = msg.resources
 		m.clusterResourceType = msg.resourceType
 		m.lastRefresh = time.Now()
+		if m.clusterInspector != nil {
+			m.clusterInspector.refresh(msg.resources)
+		}
+		cmds = append(cmds, waitForClusterWatch(msg.watch), m.applyClusterResourceChanges(msg.changed))
 
 		// Update table rows for cluster resources
 		m.updateTableRows()
 		// Then update all dimensions to ensure proper sizing
 		m.updateAllDimensions(m.width, m.height)
+		if selectedKey != "" && msg.changed != nil {
+			m.selectClusterResource(selectedKey)
+		}
 		return m, tea.Batch(cmds...)
 
+	case clusterWatchErrMsg:
+		if msg.watch != m.clusterWatch {
+			return m, nil
+		}
+		m.loading = false
+		m.err = msg.err
+		return m, waitForClusterWatch(msg.watch)
+
+	case clusterEventsLoadedMsg:
+		if ci := m.clusterInspector; ci == msg.inspector {
+			ci.events = msg.events
+			ci.eventsErr = msg.err
+			ci.eventsLoaded = true
+		}
+		return m, nil
+
+	case clusterHighlightExpiredMsg:
+		m.pruneClusterResourceChanges()
+		if m.currentView == viewClusterResources {
+			m.updateTableRows()
+		}
+		return m, nil
+
 	case accountInfoMsg:
 		m.account = msg.account
 		return m, nil
 
+	case contextSwitchedMsg:
+		// Swap the API client and drop everything loaded with the old one
+		m.client = msg.client
+		m.contextName = msg.name
+		m.activeContext = msg.context
+		m.tokenSource = msg.tokenSource
+		m.authFailed = false
+		m.loadProgress = make(map[string]loadProgressMsg)
+		m.account = nil
+		m.droplets = []godo.Droplet{}
+		m.dropletCount = 0
+		m.clusters = []*godo.KubernetesCluster{}
+		m.clusterCount = 0
+		m.clusterResources = []map[string]interface{}{}
+		m.selectedCluster = nil
+		m.stopClusterWatch()
+		m.selectedRegion = "all"
+		m.regions = []string{"all"}
+		m.billingBalance = nil
+		m.billingInvoices = []godo.InvoiceListItem{}
+		m.billingHistory = nil
+		m.selectedBillingMonth = ""
+		m.snapshots = nil
+		m.volumes = nil
+		m.firewalls = nil
+		m.firewallsErr = nil
+		m.loadBalancers = nil
+		m.lbHealth = nil
+		m.lbHealthErrs = nil
+		m.vpcs = nil
+		m.vpcMembers = nil
+		m.domains = nil
+		m.selectedDomain = ""
+		m.domainRecords = nil
+		m.databases = nil
+		m.databaseDetails = nil
+		m.spacesBuckets = nil
+		m.spacesBucket = nil
+		m.spacesPrefix = ""
+		m.spacesEntries = nil
+		m.apps = nil
+		m.selectedApp = nil
+		m.appDeployments = nil
+		m.closeLogPane()
+		m.registry = nil
+		m.registryRepos = nil
+		m.registryGCs = nil
+		m.registryResourceType = ""
+		m.registryRepo = ""
+		m.registryTags = nil
+		m.registryManifests = nil
+		m.projects = nil
+		m.projectResources = nil
+		m.activeProject = nil
+		m.projectURNs = nil
+		m.pendingProjectMove = nil
+		m.sshKeys = nil
+		m.viewingResourceDetails = false
+		if m.currentView == viewClusterResources {
+			m.currentView = viewClusters
+		}
+		m.applyDropletTemplate()
+		m.err = nil
+		m.successMsg = fmt.Sprintf("✅ Switched to context '%s' (token from %s)", msg.name, msg.tokenSource)
+		m.loading = true
+		m.updateTableRows()
+		var viewLoad tea.Cmd
+		if rv := m.resourceView(); rv != nil {
+			viewLoad = rv.load(&m)
+		}
+		return m, tea.Batch(
+			viewLoad,
+			loadDroplets(m.client, m.pageProgressChan),
+			loadClusters(m.client, m.pageProgressChan),
+			loadAccountInfo(m.client),
+			loadBalance(m.client),
+			loadInvoices(m.client, m.pageProgressChan),
+			loadBillingHistory(m.client, m.pageProgressChan),
+			m.spinner.Tick,
+		)
+
 	case regionsLoadedMsg:
+		delete(m.loadProgress, "regions")
 		m.availableRegions = msg
+		// Regions may have been loaded for the volume create flow
+		if m.pendingVolumeCreate {
+			m.pendingVolumeCreate = false
+			m.loading = false
+			m.openVolumeRegionPicker()
+		}
 		return m, nil
 
 	case sizesLoadedMsg:
+		delete(m.loadProgress, "sizes")
 		m.availableSizes = msg
+		// Sizes may have been loaded for the resize flow
+		if m.resizing != nil && m.resizing.target == nil {
+			m.loading = false
+			m.setupSelectionTable("size")
+		}
 		return m, nil
 
 	case imagesLoadedMsg:
+		delete(m.loadProgress, "images")
+		delete(m.loadProgress, "user images")
 		m.availableImages = msg
 		return m, nil
 
@@ -974,6 +1686,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case invoicesLoadedMsg:
+		delete(m.loadProgress, "invoices")
 		m.billingInvoices = msg
 		m.loading = false
 		// Update billing table after invoices are loaded
@@ -983,6 +1696,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case billingHistoryLoadedMsg:
+		delete(m.loadProgress, "billing history")
 		m.billingHistory = msg
 		m.loading = false
 		// Update billing table after history is loaded
@@ -998,16 +1712,57 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.billingDetailsScroll = 0 // Reset scroll position when loading new invoice
 		return m, nil
 
+	case logPaneLoadedMsg, logPaneTickMsg, logPaneStreamMsg:
+		return m, m.updateLogPaneMsg(msg)
+
 	case dropletMetricsLoadedMsg:
 		m.dropletMetrics = msg
 		m.loadingMetrics = false
 		return m, nil
 
+	case refreshTickMsg:
+		// Auto-refresh the current view, but never while the user is in a form,
+		// dialog or terminal, or while a load is already in flight
+		cmds = append(cmds, scheduleRefresh(m.config.refreshInterval()))
+		if m.loading || m.creating || m.confirmDelete || m.viewingDetails || m.viewingBillingDetails ||
+			m.terminal != nil || m.selectingSSHIP || m.commandMode || m.menu != nil || m.confirm != nil ||
+			m.resizing != nil || m.input != nil || m.picker != nil || m.viewingResourceDetails || m.logPane != nil ||
+			m.userDataEditor != nil || m.clusterInspector != nil {
+			return m, tea.Batch(cmds...)
+		}
+		if rv := m.resourceView(); rv != nil {
+			cmds = append(cmds, rv.load(&m))
+			return m, tea.Batch(cmds...)
+		}
+		switch m.currentView {
+		case viewDroplets:
+			cmds = append(cmds, loadDroplets(m.client, m.pageProgressChan))
+		case viewClusters:
+			cmds = append(cmds, loadClusters(m.client, m.pageProgressChan))
+		case viewClusterResources:
+			// Cluster resources update live through their watch
+		case viewBilling:
+			cmds = append(cmds, loadBalance(m.client), loadInvoices(m.client, m.pageProgressChan), loadBillingHistory(m.client, m.pageProgressChan))
+		}
+		return m, tea.Batch(cmds...)
+
 	case dropletCreatedMsg:
 		m.creating = false
-		m.successMsg = fmt.Sprintf("✅ Droplet '%s' created successfully! (ID: %d)", msg.Name, msg.ID)
+		if m.activeProject != nil {
+			// Created for the active project, so keep it visible (even if the move failed)
+			m.projectURNs[msg.droplet.URN()] = true
+		}
+		// The API never returns user data, so remember what was sent
+		if m.userDataDroplets == nil {
+			m.userDataDroplets = make(map[int]string)
+		}
+		m.userDataDroplets[msg.droplet.ID] = m.userDataLabel()
+		m.successMsg = fmt.Sprintf("✅ Droplet '%s' created successfully! (ID: %d)", msg.droplet.Name, msg.droplet.ID)
+		if msg.warning != nil {
+			m.successMsg = fmt.Sprintf("⚠️  %v (ID: %d)", msg.warning, msg.droplet.ID)
+		}
 		m.resetInputs()
-		cmds = append(cmds, loadDroplets(m.client))
+		cmds = append(cmds, loadDroplets(m.client, m.pageProgressChan))
 		return m, tea.Batch(cmds...)
 
 	case dropletDeletedMsg:
@@ -1015,110 +1770,39 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.successMsg = fmt.Sprintf("✅ Droplet '%s' deleted successfully!", m.deleteTargetName)
 		m.deleteTargetID = 0
 		m.deleteTargetName = ""
-		cmds = append(cmds, loadDroplets(m.client))
+		cmds = append(cmds, loadDroplets(m.client, m.pageProgressChan))
 		return m, tea.Batch(cmds...)
 
 	case errMsg:
 		m.err = msg
+		// A 401 means the token itself is bad - retrying other calls won't help
+		if isAuthError(msg) {
+			m.authFailed = true
+		}
+		// Any in-flight list may have been the one that failed
+		m.loadProgress = make(map[string]loadProgressMsg)
 		m.creating = false
 		m.loading = false
 		m.loadingMetrics = false
 		m.confirmDelete = false
-		// If error occurs during SSH, close terminal
-		if m.sshTerminalActive {
-			m.closeSSHTerminal()
+		m.pendingDNSDroplet = nil
+		m.pendingProjectMove = nil
+		// If error occurs in a terminal, close it
+		if m.terminal != nil {
+			m.closeTerminal()
 		}
 		return m, nil
 
-	case sshTerminalStartedMsg:
-		// SSH terminal started, store PTY and start polling for output immediately
-		m.sshTerminalPTY = msg.ptmx
-		m.sshTerminalCmd = msg.cmd
-		m.sshTerminalHost = msg.name
-		m.sshTerminalIP = msg.ip
-
-		// CRITICAL: Set PTY size immediately with current window dimensions
-		// ncurses apps need accurate terminal size from the start
-		if m.sshTerminalPTY != nil {
-			availableRows := m.height - getTopPadding() - 6 // Header + padding + help text
-			if availableRows < 5 {
-				availableRows = 5
-			}
-			availableCols := m.width - 4 // Account for border and padding
-			if availableCols < 40 {
-				availableCols = 40
-			}
-			pty.Setsize(m.sshTerminalPTY, &pty.Winsize{
-				Rows: uint16(availableRows),
-				Cols: uint16(availableCols),
-			})
-			// Also update terminal emulator size
-			if m.sshTerminalEmulator != nil {
-				m.sshTerminalEmulator.Resize(availableCols, availableRows)
-			}
-		}
-
-		// Start polling immediately with a fast ticker
-		cmds = append(cmds, waitForSSHOutput(m.sshOutputChan))
-		return m, tea.Batch(cmds...)
-
-	case sshTerminalOutputMsg:
-		// New output from SSH - process through terminal emulator
-		// CRITICAL: Use VTE to interpret ANSI escape sequences properly
-		// The terminal emulator is the SINGLE SOURCE OF TRUTH for display
-		m.sshTerminalMutex.Lock()
-		output := string(msg)
-		if len(output) > 0 {
-			// Process output through terminal emulator to interpret ANSI sequences
-			// This handles: cursor positioning, screen clearing (\r, \x1b[K, \x1b[2K), etc.
-			// CRITICAL: Process ALL bytes including newlines, carriage returns, etc.
-			if m.sshTerminalEmulator != nil && m.sshTerminalParser != nil {
-				// Process all bytes through the parser
-				// CRITICAL: Convert string back to []byte to preserve ALL control characters
-				// This ensures \n, \r, and all ANSI sequences are processed correctly
-				outputBytes := []byte(output)
-
-				// Process all bytes through the parser
-				// The parser will call methods on the terminal buffer to update the screen
-				// This MUST process every byte including \n, \r, and all ANSI sequences
-				// The emulator maintains the screen state including cursor position
-				//
-				// CRITICAL: Newlines (\n) should move cursor to next line in the buffer
-				// Carriage returns (\r) should move cursor to start of current line
-				// The emulator handles all of this - we just feed it the raw bytes
-				m.sshTerminalParser.Advance(m.sshTerminalEmulator, outputBytes)
-			}
-			// Keep raw output buffer only for debugging/fallback (not used for display)
-			if m.sshTerminalRawOutput != nil {
-				m.sshTerminalRawOutput.WriteString(output)
-				// Limit raw output buffer size (keep last 1MB for debugging)
-				rawOutputStr := m.sshTerminalRawOutput.String()
-				if len(rawOutputStr) > 1024*1024 { // 1MB
-					keepFrom := len(rawOutputStr) - 1024*1024
-					m.sshTerminalRawOutput.Reset()
-					m.sshTerminalRawOutput.WriteString(rawOutputStr[keepFrom:])
-				}
-			}
-			// The terminal emulator buffer is the SINGLE SOURCE OF TRUTH for rendering
-			// All output is processed through the emulator which handles ANSI sequences correctly
-		}
-		m.sshTerminalMutex.Unlock()
-		// Continue waiting for more output
-		cmds = append(cmds, waitForSSHOutput(m.sshOutputChan))
-		return m, tea.Batch(cmds...)
-
-	case sshTerminalClosedMsg:
-		// SSH connection closed
-		m.closeSSHTerminal()
-		return m, nil
+	case terminalStartedMsg, terminalOutputMsg, terminalClosedMsg:
+		return m.updateTerminalMsg(msg)
 
 	case time.Time:
-		// Ticker message - this is from our SSH output poller
-		// If we're in SSH terminal mode, keep the ticker running
-		if m.sshTerminalActive && m.sshTerminalPTY != nil {
+		// Ticker message - this is from our terminal output poller
+		// If a terminal is open, keep the ticker running
+		if m.terminal != nil && m.terminal.backend != nil {
 			// The ticker itself returns time.Time, so this means no message was available
 			// Just restart the ticker to keep polling
-			cmds = append(cmds, waitForSSHOutput(m.sshOutputChan))
+			cmds = append(cmds, waitForTerminalOutput(m.terminalOutputChan))
 		}
 		return m, tea.Batch(cmds...)
 
@@ -1148,27 +1832,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = rawWidth
 		m.height = rawHeight
 
-		// Update PTY size when window resizes (if SSH terminal is active)
-		// CRITICAL: ncurses apps need accurate terminal size for proper rendering
-		if m.sshTerminalPTY != nil {
-			// Calculate available terminal size (accounting for header and padding)
-			availableRows := rawHeight - getTopPadding() - 6 // Header + padding + help text
-			if availableRows < 5 {
-				availableRows = 5 // Minimum size
-			}
-			availableCols := rawWidth - 4 // Account for border and padding
-			if availableCols < 40 {
-				availableCols = 40 // Minimum width
-			}
-			pty.Setsize(m.sshTerminalPTY, &pty.Winsize{
-				Rows: uint16(availableRows),
-				Cols: uint16(availableCols),
-			})
-			// Also update terminal emulator size
-			if m.sshTerminalEmulator != nil {
-				m.sshTerminalEmulator.Resize(availableCols, availableRows)
-			}
-		}
+		// Update the terminal size when window resizes (if a terminal is open)
+		m.resizeTerminal()
 
 		// Immediately update all dynamic components
 		m.updateAllDimensions(rawWidth, rawHeight)
@@ -1423,6 +2088,11 @@ func getIPByType(d godo.Droplet, ipType string) string {
 func (m *model) updateTableRows() {
 	var rows []table.Row
 
+	if rv := m.resourceView(); rv != nil {
+		rv.updateTable(m)
+		return
+	}
+
 	if m.currentView == viewBilling {
 		// Show billing dashboard
 		m.updateBillingTable()
@@ -1473,6 +2143,9 @@ func (m *model) updateTableRows() {
 
 		// Add cluster rows
 		for _, c := range m.clusters {
+			if !m.inActiveProject(c.URN()) {
+				continue
+			}
 			status := string(c.Status.State)
 			statusColor := successColor
 			statusIcon := "●"
@@ -1595,6 +2268,9 @@ func (m *model) updateTableRows() {
 			if m.selectedRegion != "all" && d.Region.Slug != m.selectedRegion {
 				continue
 			}
+			if !m.inActiveProject(d.URN()) {
+				continue
+			}
 
 			status := d.Status
 			statusColor := successColor
@@ -2037,7 +2713,7 @@ func (m *model) updateClusterResourceTable() {
 		}
 		// Use actual resources from cluster
 		for _, r := range m.clusterResources {
-			name := truncateValue(getMapValue(r, "name", "N/A"), nameWidth)
+			name := truncateValue(m.clusterResourceMarker(r)+getMapValue(r, "name", "N/A"), nameWidth)
 			rows = append(rows, table.Row{
 				name,
 				getMapValue(r, "ready", "0/0"),
@@ -2071,7 +2747,7 @@ func (m *model) updateClusterResourceTable() {
 			{Title: "AGE", Width: ageWidth},
 		}
 		for _, r := range m.clusterResources {
-			name := truncateValue(getMapValue(r, "name", "N/A"), nameWidth)
+			name := truncateValue(m.clusterResourceMarker(r)+getMapValue(r, "name", "N/A"), nameWidth)
 			rows = append(rows, table.Row{
 				name,
 				getMapValue(r, "ready", "0/0"),
@@ -2105,7 +2781,7 @@ func (m *model) updateClusterResourceTable() {
 			{Title: "AGE", Width: ageWidth},
 		}
 		for _, r := range m.clusterResources {
-			name := truncateValue(getMapValue(r, "name", "N/A"), nameWidth)
+			name := truncateValue(m.clusterResourceMarker(r)+getMapValue(r, "name", "N/A"), nameWidth)
 			clusterIP := truncateValue(getMapValue(r, "clusterIP", "<none>"), clusterIPWidth)
 			externalIP := truncateValue(getMapValue(r, "externalIP", "<none>"), externalIPWidth)
 			rows = append(rows, table.Row{
@@ -2141,7 +2817,7 @@ func (m *model) updateClusterResourceTable() {
 			{Title: "VERSION", Width: versionWidth},
 		}
 		for _, r := range m.clusterResources {
-			name := truncateValue(getMapValue(r, "name", "N/A"), nameWidth)
+			name := truncateValue(m.clusterResourceMarker(r)+getMapValue(r, "name", "N/A"), nameWidth)
 			version := truncateValue(getMapValue(r, "version", "N/A"), versionWidth)
 			rows = append(rows, table.Row{
 				name,
@@ -2172,7 +2848,7 @@ func (m *model) updateClusterResourceTable() {
 		// Add "all" option at the top for selecting all namespaces
 		rows = append(rows, table.Row{"all", "Active", "N/A"})
 		for _, r := range m.clusterResources {
-			name := truncateValue(getMapValue(r, "name", "N/A"), nameWidth)
+			name := truncateValue(m.clusterResourceMarker(r)+getMapValue(r, "name", "N/A"), nameWidth)
 			rows = append(rows, table.Row{
 				name,
 				truncateValue(getMapValue(r, "status", "Unknown"), statusWidth),
@@ -2250,6 +2926,35 @@ func (m model) updateSSHIPSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+func (m model) updateAuthFailed(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case ":":
+		// Allow :ctx to switch to a context with a working token
+		m.commandMode = true
+		m.commandInput.Focus()
+		m.commandInput.SetValue("")
+		return m, nil
+	case "r", "enter":
+		// Retry with the same token (e.g. after fixing it at the source)
+		m.authFailed = false
+		m.err = nil
+		m.loading = true
+		cmds := []tea.Cmd{loadAccountInfo(m.client), m.spinner.Tick}
+		switch m.currentView {
+		case viewClusters, viewClusterResources:
+			cmds = append(cmds, loadClusters(m.client, m.pageProgressChan))
+		case viewBilling:
+			cmds = append(cmds, loadBalance(m.client), loadInvoices(m.client, m.pageProgressChan), loadBillingHistory(m.client, m.pageProgressChan))
+		default:
+			cmds = append(cmds, loadDroplets(m.client, m.pageProgressChan))
+		}
+		return m, tea.Batch(cmds...)
+	}
+	return m, nil
+}
+
 func (m model) updateDeleteConfirmation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "y", "Y":
@@ -2266,8 +2971,8 @@ func (m model) updateDeleteConfirmation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m model) updateCreateForm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 
-	// Handle selection mode (when selecting region, size, or image)
-	if m.selectingRegion || m.selectingSize || m.selectingImage {
+	// Handle selection mode (when selecting region, size, image or VPC)
+	if m.selectingRegion || m.selectingSize || m.selectingImage || m.selectingVPC || m.selectingSSHKeys {
 		return m.updateSelectionMode(msg)
 	}
 
@@ -2278,31 +2983,50 @@ func (m model) updateCreateForm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.err = nil
 		return m, nil
 	case "tab":
-		m.inputIndex = (m.inputIndex + 1) % 5 // 5 fields: name(0), region(1), size(2), image(3), tags(4)
+		m.inputIndex = (m.inputIndex + 1) % createFormFields
 		m.updateInputFocus()
 		return m, nil
 	case "shift+tab":
-		m.inputIndex = (m.inputIndex - 1 + 5) % 5
+		m.inputIndex = (m.inputIndex - 1 + createFormFields) % createFormFields
 		m.updateInputFocus()
 		return m, nil
 	case "enter":
-		// Open selection for region/size/image, or create droplet if on tags field
-		if m.inputIndex == 1 {
+		// Open selection for region/size/image/VPC, or create droplet if on tags field
+		if m.inputIndex == fieldRegion {
 			// Region field - open region selection
 			m.selectingRegion = true
 			m.setupSelectionTable("region")
 			return m, nil
-		} else if m.inputIndex == 2 {
+		} else if m.inputIndex == fieldSize {
 			// Size field - open size selection
 			m.selectingSize = true
 			m.setupSelectionTable("size")
 			return m, nil
-		} else if m.inputIndex == 3 {
+		} else if m.inputIndex == fieldImage {
 			// Image field - open image selection
 			m.selectingImage = true
 			m.setupSelectionTable("image")
 			return m, nil
-		} else if m.inputIndex == 4 {
+		} else if m.inputIndex == fieldVPC {
+			// VPC field - open VPC selection (filtered to the chosen region)
+			if m.selectedRegionSlug == "" {
+				m.err = fmt.Errorf("select a region first")
+				return m, nil
+			}
+			m.selectingVPC = true
+			m.setupSelectionTable("vpc")
+			return m, nil
+		} else if m.inputIndex == fieldSSHKeys {
+			// SSH keys field - open the account's keys to pick several
+			m.selectingSSHKeys = true
+			m.selectionTable.SetCursor(0)
+			m.setupSelectionTable("sshkeys")
+			return m, nil
+		} else if m.inputIndex == fieldUserData {
+			// User data field - load a file or template, or edit inline
+			m.openUserDataMenu()
+			return m, nil
+		} else if m.inputIndex == fieldTags {
 			// Tags field - create droplet
 			if m.selectedRegionSlug != "" && m.selectedSizeSlug != "" && m.selectedImageSlug != "" {
 				m.loading = true
@@ -2316,9 +3040,9 @@ func (m model) updateCreateForm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	switch m.inputIndex {
-	case 0:
+	case fieldName:
 		m.nameInput, cmd = m.nameInput.Update(msg)
-	case 4:
+	case fieldTags:
 		m.tagsInput, cmd = m.tagsInput.Update(msg)
 	}
 
@@ -2334,23 +3058,44 @@ func (m model) updateSelectionMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.selectingRegion = false
 		m.selectingSize = false
 		m.selectingImage = false
+		m.selectingVPC = false
+		m.selectingSSHKeys = false
 		return m, nil
+	case " ", "space":
+		// Toggle a key in the SSH key multi-select
+		if m.selectingSSHKeys {
+			if i := m.selectionTable.Cursor(); i >= 0 && i < len(m.sshKeys) {
+				m.toggleSSHKey(m.sshKeys[i])
+				m.setupSelectionTable("sshkeys")
+			}
+			return m, nil
+		}
 	case "enter":
+		// The SSH key selection is made with space, enter just closes it
+		if m.selectingSSHKeys {
+			m.selectingSSHKeys = false
+			m.inputIndex = fieldUserData // Move to user data field
+			return m, nil
+		}
 		// Confirm selection
 		if m.selectionTable.SelectedRow() != nil && len(m.selectionTable.SelectedRow()) > 0 {
 			var selectedSlug string
 			if m.selectingRegion {
 				// Region table: SLUG is in column 0
 				selectedSlug = m.selectionTable.SelectedRow()[0]
+				// VPCs are per region
+				if selectedSlug != m.selectedRegionSlug {
+					m.selectedVPCUUID = ""
+				}
 				m.selectedRegionSlug = selectedSlug
 				m.selectingRegion = false
-				m.inputIndex = 2 // Move to size field
+				m.inputIndex = fieldSize // Move to size field
 			} else if m.selectingSize {
 				// Size table: SLUG is in column 0
 				selectedSlug = m.selectionTable.SelectedRow()[0]
 				m.selectedSizeSlug = selectedSlug
 				m.selectingSize = false
-				m.inputIndex = 3 // Move to image field
+				m.inputIndex = fieldImage // Move to image field
 			} else if m.selectingImage {
 				// Image table: SLUG is in column 2 (DISTRIBUTION, ARCHITECTURE, SLUG)
 				if len(m.selectionTable.SelectedRow()) >= 3 {
@@ -2364,7 +3109,12 @@ func (m model) updateSelectionMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 					m.selectedImageSlug = selectedSlug
 				}
 				m.selectingImage = false
-				m.inputIndex = 4 // Move to tags field
+				m.inputIndex = fieldVPC // Move to VPC field
+			} else if m.selectingVPC {
+				// VPC table: ID is in column 2 (NAME, IP RANGE, ID), empty for the default
+				m.selectedVPCUUID = m.selectionTable.SelectedRow()[2]
+				m.selectingVPC = false
+				m.inputIndex = fieldSSHKeys // Move to SSH keys field
 			}
 		}
 		return m, nil
@@ -2405,7 +3155,7 @@ func (m *model) setupSelectionTable(selectionType string) {
 			{Title: "DISK", Width: 12},
 			{Title: "PRICE", Width: 12},
 		}
-		for _, s := range m.availableSizes {
+		for _, s := range m.selectableSizes() {
 			ramGB := float64(s.Memory) / 1024.0
 			price := "$0.00"
 			if s.PriceMonthly > 0 {
@@ -2423,9 +3173,22 @@ func (m *model) setupSelectionTable(selectionType string) {
 		columns = []table.Column{
 			{Title: "DISTRIBUTION", Width: 20},
 			{Title: "ARCHITECTURE", Width: 15},
-			{Title: "SLUG", Width: 35},
+			{Title: "SLUG / ID", Width: 35},
 		}
 		for _, img := range m.availableImages {
+			// Snapshots and custom images are created by ID, and only in the regions they're in
+			if img.Slug == "" {
+				if m.selectedRegionSlug != "" && !containsString(img.Regions, m.selectedRegionSlug) {
+					continue
+				}
+				rows = append(rows, table.Row{
+					fmt.Sprintf("%s: %s", imageTypeLabel(img.Type), img.Name),
+					"-",
+					strconv.Itoa(img.ID),
+				})
+				continue
+			}
+
 			// Determine architecture from slug
 			slugLower := strings.ToLower(img.Slug)
 			architecture := "x64"
@@ -2476,6 +3239,38 @@ func (m *model) setupSelectionTable(selectionType string) {
 				img.Slug, // Use full slug, not truncated
 			})
 		}
+	case "vpc":
+		columns = []table.Column{
+			{Title: "NAME", Width: 25},
+			{Title: "IP RANGE", Width: 18},
+			{Title: "ID", Width: 36},
+		}
+		// An empty ID lets DigitalOcean use the region's default VPC
+		rows = append(rows, table.Row{"(region default)", "", ""})
+		for _, vpc := range m.vpcs {
+			if vpc.RegionSlug != m.selectedRegionSlug {
+				continue
+			}
+			name := vpc.Name
+			if vpc.Default {
+				name += " (default)"
+			}
+			rows = append(rows, table.Row{name, vpc.IPRange, vpc.ID})
+		}
+	case "sshkeys":
+		columns = []table.Column{
+			{Title: "", Width: 3},
+			{Title: "NAME", Width: 25},
+			{Title: "TYPE", Width: 12},
+			{Title: "FINGERPRINT", Width: 50},
+		}
+		for _, k := range m.sshKeys {
+			selected := ""
+			if m.sshKeySelected(k) {
+				selected = "✓"
+			}
+			rows = append(rows, table.Row{selected, k.Name, sshKeyType(k.PublicKey), k.Fingerprint})
+		}
 	}
 
 	// CRITICAL: Clear rows FIRST before setting columns
@@ -2506,9 +3301,9 @@ func (m *model) updateInputFocus() {
 	m.tagsInput.Blur()
 
 	switch m.inputIndex {
-	case 0:
+	case fieldName:
 		m.nameInput.Focus()
-	case 4:
+	case fieldTags:
 		m.tagsInput.Focus()
 	}
 }
@@ -2516,13 +3311,20 @@ func (m *model) updateInputFocus() {
 func (m *model) resetInputs() {
 	m.nameInput.SetValue("")
 	m.tagsInput.SetValue("")
-	m.inputIndex = 0
+	m.inputIndex = fieldName
 	m.selectedRegionSlug = ""
 	m.selectedSizeSlug = ""
 	m.selectedImageSlug = ""
+	m.selectedVPCUUID = ""
+	m.selectedSSHKeys = nil
+	m.setUserData("", "")
+	m.userDataEditor = nil
+	m.applyDropletTemplate()
 	m.selectingRegion = false
 	m.selectingSize = false
 	m.selectingImage = false
+	m.selectingVPC = false
+	m.selectingSSHKeys = false
 }
 
 func (m model) updateCommandMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
@@ -2545,6 +3347,42 @@ func (m model) updateCommandMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Context switching: ":ctx" lists contexts, ":ctx <name>" switches
+		fields := strings.Fields(command)
+		switch strings.ToLower(fields[0]) {
+		case "ctx", "context":
+			if len(fields) == 1 {
+				names := m.config.contextNames()
+				if len(names) == 0 {
+					m.successMsg = fmt.Sprintf("Context: %s (no named contexts configured)", m.contextLabel())
+					return m, nil
+				}
+				for i, name := range names {
+					if name == m.contextName {
+						names[i] = "*" + name
+					}
+				}
+				m.successMsg = fmt.Sprintf("Contexts: %s", strings.Join(names, ", "))
+				return m, nil
+			}
+			// The action's remaining steps belong to this account
+			if m.runningAction != nil {
+				m.err = fmt.Errorf("can't switch context while %s is running", m.runningAction.title)
+				return m, nil
+			}
+			m.err = nil
+			m.loading = true
+			return m, tea.Batch(switchContext(m.config, fields[1]), m.spinner.Tick)
+		case "project", "proj":
+			// ":project" shows the filter, ":project <name>" filters every view, ":project all" clears it
+			return m, m.runProjectCommand(fields[1:])
+		}
+
+		// Resource views (snapshots, ...)
+		if rv := findResourceView(strings.ToLower(fields[0])); rv != nil {
+			return m, m.switchToResourceView(rv)
+		}
+
 		// Handle resource type switching
 		validResources := map[string]bool{
 			"deployments":  true,
@@ -2563,13 +3401,17 @@ func (m model) updateCommandMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		commandLower := strings.ToLower(command)
 
 		if validResources[commandLower] {
+			if m.currentView != viewClusterResources || m.selectedCluster == nil {
+				m.err = fmt.Errorf("%s: enter a cluster first", commandLower)
+				return m, nil
+			}
 			m.clusterResourceType = commandLower
 			m.loading = true
 			m.updateTableRows()
-			return m, tea.Batch(loadClusterResources(m.client, m.selectedCluster, m.clusterResourceType, m.selectedNamespace), m.spinner.Tick)
+			return m, m.watchClusterResources()
 		}
 
-		// If command not recognized, show error (could be enhanced)
+		m.err = fmt.Errorf("unknown command: %s", command)
 		return m, nil
 	}
 
@@ -2601,9 +3443,12 @@ func (m model) renderCommandMode() string {
 	s.WriteString("\n")
 	s.WriteString(commandLine)
 
-	// Show available resources - truncate if too long
-	availableResources := []string{"deployments", "pods", "services", "daemonsets", "statefulsets", "pvc", "configmaps", "secrets", "nodes", "namespaces"}
-	helpText := fmt.Sprintf("Available: %s", strings.Join(availableResources, ", "))
+	// Show available commands - truncate if too long
+	availableCommands := append(resourceViewNames(), "ctx [name]", "project [name|all]")
+	if m.currentView == viewClusterResources {
+		availableCommands = append([]string{"deployments", "pods", "services", "daemonsets", "statefulsets", "pvc", "configmaps", "secrets", "nodes", "namespaces"}, availableCommands...)
+	}
+	helpText := fmt.Sprintf("Available: %s", strings.Join(availableCommands, ", "))
 	maxHelpLen := m.width - 4
 	if len(helpText) > maxHelpLen {
 		// Truncate help text to fit
@@ -2623,10 +3468,28 @@ func (m model) View() string {
 	var content string
 
 	// Get the content from the appropriate render function
-	if m.sshTerminalActive {
-		content = m.renderSSHTerminal()
+	if m.terminal != nil {
+		content = m.renderTerminal()
 	} else if m.commandMode {
 		content = m.renderCommandMode()
+	} else if m.authFailed {
+		content = m.renderAuthFailed()
+	} else if m.confirm != nil {
+		content = m.renderConfirmDialog()
+	} else if m.menu != nil {
+		content = m.renderMenuDialog()
+	} else if m.input != nil {
+		content = m.renderInputDialog()
+	} else if m.picker != nil {
+		content = m.renderPickerDialog()
+	} else if m.logPane != nil {
+		content = m.renderLogPane()
+	} else if m.userDataEditor != nil {
+		content = m.renderUserDataEditor()
+	} else if m.clusterInspector != nil {
+		content = m.renderClusterInspector()
+	} else if m.resizing != nil {
+		content = m.renderResize()
 	} else if m.selectingSSHIP {
 		content = m.renderSSHIPSelection()
 	} else if m.confirmDelete {
@@ -2635,6 +3498,8 @@ func (m model) View() string {
 		content = m.renderCreateForm()
 	} else if m.viewingBillingDetails {
 		content = m.renderBillingDetails()
+	} else if rv := m.resourceView(); rv != nil && m.viewingResourceDetails && rv.renderDetails != nil {
+		content = m.renderResourceDetails(rv)
 	} else if m.viewingDetails {
 		if m.selectedDroplet != nil {
 			content = m.renderDropletDetails()
@@ -2880,6 +3745,9 @@ func (m model) renderTopBarTwoPanel() string {
 	rightContent.WriteString(keyStyle.Render("r") + " Refresh | ")
 	rightContent.WriteString(keyStyle.Render("d") + " Delete | ")
 	rightContent.WriteString(keyStyle.Render("s") + " SSH | ")
+	rightContent.WriteString(keyStyle.Render("a") + " Actions | ")
+	rightContent.WriteString(keyStyle.Render("w") + " DNS | ")
+	rightContent.WriteString(keyStyle.Render("m") + " Project | ")
 	rightContent.WriteString(keyStyle.Render("enter") + " View | ")
 	rightContent.WriteString(keyStyle.Render("q") + " Quit")
 	rightContent.WriteString("\n")
@@ -2952,7 +3820,7 @@ func (m model) renderTopBarK9sStyle() string {
 
 	// Left panel - Context/Account info (k9s style)
 	var leftContent strings.Builder
-	leftContent.WriteString(labelStyle.Render("Context: ") + valueStyle.Render("DigitalOcean"))
+	leftContent.WriteString(labelStyle.Render("Context: ") + valueStyle.Render(truncateString(m.contextLabel(), leftWidth-10)))
 	leftContent.WriteString("\n")
 
 	if m.currentView == viewClusterResources {
@@ -2988,6 +3856,10 @@ func (m model) renderTopBarK9sStyle() string {
 			leftContent.WriteString(labelStyle.Render("Count: ") + valueStyle.Render(fmt.Sprintf("%d", len(m.clusterResources))))
 			leftContent.WriteString("\n")
 		}
+	} else if rv := m.resourceView(); rv != nil {
+		leftContent.WriteString(labelStyle.Render("View: ") + valueStyle.Render(rv.title))
+		leftContent.WriteString("\n")
+		leftContent.WriteString(labelStyle.Render("Count: ") + valueStyle.Render(fmt.Sprintf("%d", rv.count(&m))))
 	} else if m.currentView == viewClusters {
 		leftContent.WriteString(labelStyle.Render("View: ") + valueStyle.Render("Kubernetes Clusters"))
 		leftContent.WriteString("\n")
@@ -3040,17 +3912,7 @@ func (m model) renderTopBarK9sStyle() string {
 	leftContent.WriteString("\n")
 
 	if m.account != nil && m.currentView != viewClusterResources {
-		// Truncate email to fit panel width
-		email := m.account.Email
-		maxEmailLen := leftWidth - 10 // Reserve space for "Account: " label
-		if maxEmailLen < 5 {
-			maxEmailLen = 5
-		}
-		if len(email) > maxEmailLen {
-			email = truncateString(email, maxEmailLen)
-		}
-		leftContent.WriteString(labelStyle.Render("Account: ") + valueStyle.Render(email))
-		leftContent.WriteString("\n")
+		// Account email is shown next to the context name above
 		// Truncate status if needed
 		status := m.account.Status
 		maxStatusLen := leftWidth - 9 // Reserve space for "Status: " label
@@ -3108,6 +3970,13 @@ func (m model) renderTopBarK9sStyle() string {
 		middleContent.WriteString(keyStyle.Render("d") + " Next\n")
 		middleContent.WriteString(keyStyle.Render("n") + " Namespace\n")
 		middleContent.WriteString(keyStyle.Render("r") + " Refresh\n")
+		if m.clusterResourceType == "pods" {
+			middleContent.WriteString(keyStyle.Render("l") + " Logs\n")
+			middleContent.WriteString(keyStyle.Render("x") + " Shell\n")
+		}
+		if m.clusterResourceType != "namespaces" {
+			middleContent.WriteString(keyStyle.Render("y") + " YAML\n")
+		}
 		middleContent.WriteString(keyStyle.Render("enter") + " Details\n")
 		middleContent.WriteString(keyStyle.Render("esc") + " Back\n")
 		middleContent.WriteString(keyStyle.Render("q") + " Quit")
@@ -3120,8 +3989,10 @@ func (m model) renderTopBarK9sStyle() string {
 		middleContent.WriteString(keyStyle.Render("d") + " Delete\n")
 		middleContent.WriteString(keyStyle.Render("s") + " SSH\n")
 		middleContent.WriteString(keyStyle.Render("enter") + " Details\n")
-		middleContent.WriteString(keyStyle.Render("?") + " Help\n")
+		middleContent.WriteString(keyStyle.Render("a") + " Actions\n")
 		middleContent.WriteString(keyStyle.Render("q") + " Quit")
+	} else if rv := m.resourceView(); rv != nil {
+		middleContent.WriteString(rv.renderKeys("%s", "\n"))
 	} else if m.currentView == viewClusters {
 		// Clusters view
 		middleContent.WriteString(keyStyle.Render("1") + " Droplets\n")
@@ -3211,7 +4082,7 @@ func (m model) renderTopBarTwoPanelK9s() string {
 
 	// Left panel - Context info (ensure all info is visible)
 	var leftContent strings.Builder
-	leftContent.WriteString(labelStyle.Render("Context: ") + valueStyle.Render("DigitalOcean"))
+	leftContent.WriteString(labelStyle.Render("Context: ") + valueStyle.Render(truncateString(m.contextLabel(), panelWidth-10)))
 	leftContent.WriteString("\n")
 
 	if m.currentView == viewClusterResources && m.selectedCluster != nil {
@@ -3228,6 +4099,10 @@ func (m model) renderTopBarTwoPanelK9s() string {
 		leftContent.WriteString(labelStyle.Render("Resource: ") + valueStyle.Render(strings.Title(m.clusterResourceType)))
 		leftContent.WriteString("\n")
 		leftContent.WriteString(labelStyle.Render("Count: ") + valueStyle.Render(fmt.Sprintf("%d", len(m.clusterResources))))
+	} else if rv := m.resourceView(); rv != nil {
+		leftContent.WriteString(labelStyle.Render("View: ") + valueStyle.Render(rv.title))
+		leftContent.WriteString("\n")
+		leftContent.WriteString(labelStyle.Render("Count: ") + valueStyle.Render(fmt.Sprintf("%d", rv.count(&m))))
 	} else if m.currentView == viewClusters {
 		leftContent.WriteString(labelStyle.Render("View: ") + valueStyle.Render("Kubernetes Clusters"))
 		leftContent.WriteString("\n")
@@ -3311,8 +4186,10 @@ func (m model) renderTopBarTwoPanelK9s() string {
 		rightContent.WriteString(keyStyle.Render("d") + " Delete\n")
 		rightContent.WriteString(keyStyle.Render("s") + " SSH\n")
 		rightContent.WriteString(keyStyle.Render("enter") + " Details\n")
-		rightContent.WriteString(keyStyle.Render("?") + " Help\n")
+		rightContent.WriteString(keyStyle.Render("a") + " Actions\n")
 		rightContent.WriteString(keyStyle.Render("q") + " Quit")
+	} else if rv := m.resourceView(); rv != nil {
+		rightContent.WriteString(rv.renderKeys("%s", "\n"))
 	} else if m.currentView == viewClusters {
 		rightContent.WriteString(keyStyle.Render("1") + " Droplets\n")
 		rightContent.WriteString(keyStyle.Render("2") + " Clusters\n")
@@ -3342,6 +4219,13 @@ func (m model) renderTopBarTwoPanelK9s() string {
 		rightContent.WriteString(keyStyle.Render("d") + " Next\n")
 		rightContent.WriteString(keyStyle.Render("n") + " Namespace\n")
 		rightContent.WriteString(keyStyle.Render("r") + " Refresh\n")
+		if m.currentView == viewClusterResources && m.clusterResourceType == "pods" {
+			rightContent.WriteString(keyStyle.Render("l") + " Logs\n")
+			rightContent.WriteString(keyStyle.Render("x") + " Shell\n")
+		}
+		if m.currentView == viewClusterResources && m.clusterResourceType != "namespaces" {
+			rightContent.WriteString(keyStyle.Render("y") + " YAML\n")
+		}
 		rightContent.WriteString(keyStyle.Render("esc") + " Back\n")
 		rightContent.WriteString(keyStyle.Render("q") + " Quit")
 	}
@@ -3366,13 +4250,17 @@ func (m model) renderTopBarCompactK9s() string {
 	var s strings.Builder
 	s.WriteString(headerStyle.Render("DigitalOcean"))
 	s.WriteString(" | ")
+	s.WriteString(labelStyle.Render("Context: ") + valueStyle.Render(m.contextLabel()))
+	s.WriteString(" | ")
 	s.WriteString(labelStyle.Render("Droplets: ") + valueStyle.Render(fmt.Sprintf("%d", m.dropletCount)))
 	s.WriteString(" | ")
 	s.WriteString(labelStyle.Render("Region: ") + valueStyle.Render(m.selectedRegion))
 	s.WriteString("\n")
 	var keybindings string
 	if m.currentView == "droplets" {
-		keybindings = keyStyle.Render("<1>") + " Droplets | " + keyStyle.Render("<2>") + " Clusters | " + keyStyle.Render("<3>") + " Billing | " + keyStyle.Render("<n>") + " New | " + keyStyle.Render("<r>") + " Refresh | " + keyStyle.Render("<d>") + " Delete | " + keyStyle.Render("<s>") + " SSH | " + keyStyle.Render("<q>") + " Quit"
+		keybindings = keyStyle.Render("<1>") + " Droplets | " + keyStyle.Render("<2>") + " Clusters | " + keyStyle.Render("<3>") + " Billing | " + keyStyle.Render("<n>") + " New | " + keyStyle.Render("<r>") + " Refresh | " + keyStyle.Render("<d>") + " Delete | " + keyStyle.Render("<s>") + " SSH | " + keyStyle.Render("<a>") + " Actions | " + keyStyle.Render("<w>") + " DNS | " + keyStyle.Render("<m>") + " Project | " + keyStyle.Render("<q>") + " Quit"
+	} else if rv := m.resourceView(); rv != nil {
+		keybindings = keyStyle.Render("<1>") + " Droplets | " + rv.renderKeys("<%s>", " | ")
 	} else if m.currentView == viewClusters {
 		keybindings = keyStyle.Render("<1>") + " Droplets | " + keyStyle.Render("<2>") + " Clusters | " + keyStyle.Render("<3>") + " Billing | " + keyStyle.Render("<r>") + " Refresh | " + keyStyle.Render("<enter>") + " Enter | " + keyStyle.Render("<q>") + " Quit"
 	} else if m.currentView == viewBilling {
@@ -3384,7 +4272,14 @@ func (m model) renderTopBarCompactK9s() string {
 		}
 		keybindings += " | " + keyStyle.Render("<q>") + " Quit"
 	} else {
-		keybindings = keyStyle.Render("<1>") + " Droplets | " + keyStyle.Render("<2>") + " Clusters | " + keyStyle.Render("<:>") + " Command | " + keyStyle.Render("<d>") + " Next | " + keyStyle.Render("<n>") + " Namespace | " + keyStyle.Render("<r>") + " Refresh | " + keyStyle.Render("<esc>") + " Back | " + keyStyle.Render("<q>") + " Quit"
+		keybindings = keyStyle.Render("<1>") + " Droplets | " + keyStyle.Render("<2>") + " Clusters | " + keyStyle.Render("<:>") + " Command | " + keyStyle.Render("<d>") + " Next | " + keyStyle.Render("<n>") + " Namespace | " + keyStyle.Render("<r>") + " Refresh | "
+		if m.currentView == viewClusterResources && m.clusterResourceType == "pods" {
+			keybindings += keyStyle.Render("<l>") + " Logs | " + keyStyle.Render("<x>") + " Shell | "
+		}
+		if m.currentView == viewClusterResources && m.clusterResourceType != "namespaces" {
+			keybindings += keyStyle.Render("<enter>") + " Describe | " + keyStyle.Render("<y>") + " YAML | "
+		}
+		keybindings += keyStyle.Render("<esc>") + " Back | " + keyStyle.Render("<q>") + " Quit"
 	}
 	s.WriteString(keybindings)
 	return s.String()
@@ -3514,7 +4409,7 @@ func (m model) renderTopBarVertical() string {
 	s.WriteString("\n")
 	s.WriteString(labelStyle.Render("Refresh: ") + valueStyle.Render(refreshTime))
 	s.WriteString(" | ")
-	s.WriteString(keyStyle.Render("n") + " New | " + keyStyle.Render("r") + " Refresh | " + keyStyle.Render("d") + " Delete | " + keyStyle.Render("s") + " SSH | " + keyStyle.Render("q") + " Quit")
+	s.WriteString(keyStyle.Render("n") + " New | " + keyStyle.Render("r") + " Refresh | " + keyStyle.Render("d") + " Delete | " + keyStyle.Render("s") + " SSH | " + keyStyle.Render("a") + " Actions | " + keyStyle.Render("w") + " DNS | " + keyStyle.Render("m") + " Project | " + keyStyle.Render("q") + " Quit")
 	result := s.String()
 	// Ensure we return something
 	if strings.TrimSpace(result) == "" {
@@ -3535,10 +4430,33 @@ func truncateString(s string, maxLen int) string {
 	return s[:maxLen-3] + "..."
 }
 
+// loadProgressText describes lists that are still streaming in page by page,
+// e.g. "droplets: loaded 200 of 734", sorted by resource. Single-page lists
+// are not shown.
+func (m model) loadProgressText() string {
+	resources := make([]string, 0, len(m.loadProgress))
+	for resource := range m.loadProgress {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	var parts []string
+	for _, resource := range resources {
+		p := m.loadProgress[resource]
+		if p.total <= p.loaded {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s: loaded %d of %d", resource, p.loaded, p.total))
+	}
+	return strings.Join(parts, ", ")
+}
+
 func (m model) renderStatusBar() string {
 	// k9s-style footer showing current view type
 	var statusText string
-	if m.currentView == viewBilling {
+	if rv := m.resourceView(); rv != nil {
+		statusText = fmt.Sprintf("<%s> | %s [%d]", rv.name, rv.title, rv.count(&m))
+	} else if m.currentView == viewBilling {
 		statusText = "<billing>"
 		historyCount := 0
 		if m.billingHistory != nil && m.billingHistory.BillingHistory != nil {
@@ -3573,7 +4491,11 @@ func (m model) renderStatusBar() string {
 		statusText = fmt.Sprintf("%s | Droplets(%s) [%d]", statusText, region, m.dropletCount)
 	}
 
-	if m.loading {
+	if m.runningAction != nil {
+		statusText = fmt.Sprintf("%s | %s %s", statusText, m.spinner.View(), m.runningAction.statusText())
+	} else if progress := m.loadProgressText(); progress != "" {
+		statusText = fmt.Sprintf("%s | %s %s", statusText, m.spinner.View(), progress)
+	} else if m.loading {
 		statusText = fmt.Sprintf("%s | %s Loading...", statusText, m.spinner.View())
 	}
 
@@ -3678,206 +4600,39 @@ func (m model) renderSSHIPSelection() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
 }
 
-func (m model) renderSSHTerminal() string {
+func (m model) renderAuthFailed() string {
 	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
 
-	// Show exit confirmation dialog if needed
-	if m.sshTerminalConfirmExit {
-		return m.renderSSHExitConfirmation()
+	boxWidth := min(m.width-4, 70)
+	if boxWidth < 40 {
+		boxWidth = 40
 	}
 
-	// Prominent header showing connection info - make it very visible
-	hostInfo := fmt.Sprintf("🔌 Connected to: %s", m.sshTerminalHost)
-	ipInfo := fmt.Sprintf("IP: %s", m.sshTerminalIP)
-
-	headerStyle := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(primaryColor).
-		BorderTop(true).
-		BorderBottom(true).
-		BorderLeft(true).
-		BorderRight(true).
-		Padding(1, 2).
-		Width(m.width - 2).
-		Foreground(lipgloss.Color("255")).
-		Background(primaryColor).
-		Bold(true)
-
-	hostStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("255")).
-		Bold(true).
-		Background(primaryColor)
-
-	ipStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("229")).
-		Background(primaryColor)
-
-	headerContent := fmt.Sprintf("%s  |  %s",
-		hostStyle.Render(hostInfo),
-		ipStyle.Render(ipInfo))
-
-	headerBox := headerStyle.Render(headerContent)
-	s.WriteString(headerBox)
-	s.WriteString("\n")
-
-	// Terminal output area - reserve space for header and padding
-	availableHeight := m.height - getTopPadding() - 6 // Header + padding + help text
-	if availableHeight < 5 {
-		availableHeight = 5
+	source := m.tokenSource
+	if source == "" {
+		source = "unknown"
 	}
-
-	// CRITICAL: Calculate display dimensions - must match PTY and emulator sizes exactly
-	displayCols := m.width - 4 // Account for border and padding
-	displayRows := availableHeight
-
-	// Render terminal output - use terminal emulator as SINGLE SOURCE OF TRUTH
-	// CRITICAL: The emulator IS the screen buffer - render it exactly as-is
-	// No line manipulation, no splitting, no padding - just render the buffer directly
-	m.sshTerminalMutex.Lock()
-	var displayContent string
-
-	// Use terminal emulator as SINGLE SOURCE OF TRUTH for rendering
-	// The emulator maintains a cell-by-cell screen buffer
-	if m.sshTerminalEmulator != nil {
-		// CRITICAL: Ensure emulator size matches display area exactly BEFORE getting display
-		// Size mismatch causes cursor positioning errors, broken rendering, etc.
-		currentWidth, currentHeight := m.sshTerminalEmulator.Dimensions()
-		if currentWidth != displayCols || currentHeight != displayRows {
-			// Resize emulator to match display area exactly
-			m.sshTerminalEmulator.Resize(displayCols, displayRows)
-		}
-
-		// Get the screen buffer directly from emulator
-		// GetDisplayWithColors() returns the screen state with ANSI color codes
-		// The emulator maintains a cell-by-cell screen buffer of size displayRows x displayCols
-		//
-		// CRITICAL: The emulator processes ALL escape sequences:
-		// - Newlines (\n) - moves cursor to next line, creates new row in buffer
-		// - Carriage returns (\r) - moves cursor to start of current line
-		// - Line clearing (ESC[K, ESC[2K) - clears line content
-		// - Cursor positioning - all handled by emulator
-		//
-		// GetDisplayWithColors() returns a string representation of the screen buffer
-		// The format should be: each screen row as one line, separated by \n
-		// Each line contains ANSI color codes and is exactly displayCols characters
-		displayContent = m.sshTerminalEmulator.GetDisplayWithColors()
-
-		// CRITICAL: Verify and ensure proper line structure
-		// GetDisplayWithColors() should return displayRows lines separated by \n
-		// If it doesn't, we need to fix it
-		lines := strings.Split(displayContent, "\n")
-
-		// Remove empty trailing line if present
-		if len(lines) > 0 && lines[len(lines)-1] == "" {
-			lines = lines[:len(lines)-1]
-		}
-
-		// CRITICAL: We MUST have exactly displayRows lines
-		// Each line represents one screen row - missing lines cause rendering issues
-		if len(lines) < displayRows {
-			// Pad with empty lines (screen hasn't filled all rows yet)
-			for len(lines) < displayRows {
-				lines = append(lines, "")
-			}
-		} else if len(lines) > displayRows {
-			// Take only the last displayRows lines (most recent screen content)
-			lines = lines[len(lines)-displayRows:]
-		}
-
-		// Join with \n - CRITICAL: This creates visual line breaks
-		// Without \n separators, lines will overlap and render incorrectly
-		// Each \n in the final output creates a new line in the terminal
-		displayContent = strings.Join(lines, "\n")
-	} else {
-		// Emulator not initialized yet - show connection message
-		displayContent = fmt.Sprintf("🔌 Connecting to %s (%s)...", m.sshTerminalHost, m.sshTerminalIP)
-		// Pad to fill available space (only for connection message, not terminal output)
-		lines := []string{displayContent}
-		for len(lines) < displayRows {
-			lines = append(lines, "")
-		}
-		displayContent = strings.Join(lines, "\n")
-	}
-	m.sshTerminalMutex.Unlock()
-
-	// displayContent is the screen buffer from terminal emulator
-	// It contains ANSI color codes and represents the current terminal state
-	// Each line is exactly displayCols characters wide (from emulator)
-	// Lines are separated by \n
-	//
-	// CRITICAL: Render with minimal lipgloss styling to preserve newlines
-	// Padding(1, 1) should preserve newlines, but we'll render directly
-	// The emulator buffer already has the correct format with proper newlines
-	terminalBox := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(borderColor).
-		Padding(1, 1).
-		Render(displayContent)
-
-	s.WriteString(terminalBox)
-	s.WriteString("\n\n")
-
-	// Help text with host reminder
-	hostReminder := fmt.Sprintf("Host: %s", m.sshTerminalHost)
-	helpText := helpStyle.Render(fmt.Sprintf("%s  |  [ctrl+c] Terminate Process  [esc] Exit SSH",
-		hostReminder))
-	s.WriteString(helpText)
-	s.WriteString("\n")
-
-	return s.String()
-}
-
-// renderSSHExitConfirmation renders the exit confirmation dialog
-func (m model) renderSSHExitConfirmation() string {
-	var s strings.Builder
-
-	// Prominent header still visible
-	hostInfo := fmt.Sprintf("🔌 Connected to: %s", m.sshTerminalHost)
-	ipInfo := fmt.Sprintf("IP: %s", m.sshTerminalIP)
-
-	headerStyle := lipgloss.NewStyle().
+	errorBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(primaryColor).
-		BorderTop(true).
-		BorderBottom(true).
-		BorderLeft(true).
-		BorderRight(true).
+		BorderForeground(errorColor).
 		Padding(1, 2).
-		Width(m.width - 2).
-		Foreground(lipgloss.Color("255")).
-		Background(primaryColor).
-		Bold(true)
-
-	hostStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("255")).
-		Bold(true).
-		Background(primaryColor)
-
-	ipStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("229")).
-		Background(primaryColor)
-
-	headerContent := fmt.Sprintf("%s  |  %s",
-		hostStyle.Render(hostInfo),
-		ipStyle.Render(ipInfo))
-
-	headerBox := headerStyle.Render(headerContent)
-	s.WriteString(headerBox)
-	s.WriteString("\n\n")
-
-	// Confirmation dialog
-	confirmText := fmt.Sprintf("⚠️  Close SSH connection to %s?", m.sshTerminalHost)
-	confirmBox := lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(warningColor).
-		Padding(2, 4).
-		Width(m.width - 4).
-		Render(fmt.Sprintf("%s\n\n%s\n\n%s",
-			confirmText,
-			keyStyle.Render("[y]")+" Yes, close connection  |  "+keyStyle.Render("[n]")+" No, cancel",
-			keyStyle.Render("[esc]")+" Cancel"))
+		Width(boxWidth).
+		Render(
+			fmt.Sprintf(
+				"🔒 API token invalid or expired\n\n"+
+					"DigitalOcean rejected the token (401 Unauthorized).\n\n"+
+					"Context: %s\nToken source: %s\n\n"+
+					"Generate a new token at cloud.digitalocean.com/account/api/tokens\n"+
+					"and update the source above, or switch context with :ctx <name>.\n\n"+
+					"[r] Retry  [:] Command  [q] Quit",
+				truncateString(m.contextName, boxWidth-15),
+				truncateString(source, boxWidth-20),
+			),
+		)
 
-	s.WriteString(lipgloss.Place(m.width, m.height-6, lipgloss.Center, lipgloss.Center, confirmBox))
+	s.WriteString(lipgloss.PlaceHorizontal(m.width, lipgloss.Center, errorBox))
+	s.WriteString("\n")
 
 	return s.String()
 }
@@ -3919,7 +4674,7 @@ func (m model) renderDeleteConfirmation() string {
 
 func (m model) renderCreateForm() string {
 	// If in selection mode, show selection table
-	if m.selectingRegion || m.selectingSize || m.selectingImage {
+	if m.selectingRegion || m.selectingSize || m.selectingImage || m.selectingVPC || m.selectingSSHKeys {
 		return m.renderSelectionView()
 	}
 
@@ -3932,18 +4687,18 @@ func (m model) renderCreateForm() string {
 
 	// Name field
 	labelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
-	if m.inputIndex == 0 {
+	if m.inputIndex == fieldName {
 		labelStyle = labelStyle.Foreground(primaryColor).Bold(true)
 	}
 	s.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), m.nameInput.View()))
-	if m.inputIndex == 0 {
+	if m.inputIndex == fieldName {
 		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("e.g., my-droplet")))
 	}
 	s.WriteString("\n")
 
 	// Region field (selection)
 	regionLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
-	if m.inputIndex == 1 {
+	if m.inputIndex == fieldRegion {
 		regionLabelStyle = regionLabelStyle.Foreground(primaryColor).Bold(true)
 	}
 	regionValue := m.selectedRegionSlug
@@ -3953,14 +4708,14 @@ func (m model) renderCreateForm() string {
 		regionValue = lipgloss.NewStyle().Foreground(successColor).Render(regionValue)
 	}
 	s.WriteString(fmt.Sprintf("%s %s\n", regionLabelStyle.Render("Region:"), regionValue))
-	if m.inputIndex == 1 {
+	if m.inputIndex == fieldRegion {
 		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to select from list")))
 	}
 	s.WriteString("\n")
 
 	// Size field (selection)
 	sizeLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
-	if m.inputIndex == 2 {
+	if m.inputIndex == fieldSize {
 		sizeLabelStyle = sizeLabelStyle.Foreground(primaryColor).Bold(true)
 	}
 	sizeValue := m.selectedSizeSlug
@@ -3970,39 +4725,98 @@ func (m model) renderCreateForm() string {
 		sizeValue = lipgloss.NewStyle().Foreground(successColor).Render(sizeValue)
 	}
 	s.WriteString(fmt.Sprintf("%s %s\n", sizeLabelStyle.Render("Size:"), sizeValue))
-	if m.inputIndex == 2 {
+	if m.inputIndex == fieldSize {
 		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to select from list")))
 	}
 	s.WriteString("\n")
 
 	// Image field (selection)
 	imageLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
-	if m.inputIndex == 3 {
+	if m.inputIndex == fieldImage {
 		imageLabelStyle = imageLabelStyle.Foreground(primaryColor).Bold(true)
 	}
 	imageValue := m.selectedImageSlug
+	for _, img := range m.availableImages {
+		if img.Slug == "" && strconv.Itoa(img.ID) == imageValue {
+			imageValue = fmt.Sprintf("%s (%s: %d)", img.Name, imageTypeLabel(img.Type), img.ID)
+			break
+		}
+	}
 	if imageValue == "" {
 		imageValue = lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to select")
 	} else {
 		imageValue = lipgloss.NewStyle().Foreground(successColor).Render(imageValue)
 	}
-	s.WriteString(fmt.Sprintf("%s %s\n", imageLabelStyle.Render("Image:"), imageValue))
-	if m.inputIndex == 3 {
-		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to select from list")))
+	s.WriteString(fmt.Sprintf("%s %s\n", imageLabelStyle.Render("Image:"), imageValue))
+	if m.inputIndex == fieldImage {
+		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to select from list")))
+	}
+	s.WriteString("\n")
+
+	// VPC field (selection)
+	vpcLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
+	if m.inputIndex == fieldVPC {
+		vpcLabelStyle = vpcLabelStyle.Foreground(primaryColor).Bold(true)
+	}
+	vpcValue := lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Region default")
+	if m.selectedVPCUUID != "" {
+		vpcValue = m.selectedVPCUUID
+		if vpc := m.vpcByID(m.selectedVPCUUID); vpc != nil {
+			vpcValue = fmt.Sprintf("%s (%s)", vpc.Name, vpc.IPRange)
+		}
+		vpcValue = lipgloss.NewStyle().Foreground(successColor).Render(vpcValue)
+	}
+	s.WriteString(fmt.Sprintf("%s %s\n", vpcLabelStyle.Render("VPC:"), vpcValue))
+	if m.inputIndex == fieldVPC {
+		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to select from the region's VPCs")))
+	}
+	s.WriteString("\n")
+
+	// SSH keys field (multi-select)
+	sshKeysLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
+	if m.inputIndex == fieldSSHKeys {
+		sshKeysLabelStyle = sshKeysLabelStyle.Foreground(primaryColor).Bold(true)
+	}
+	sshKeysValue := lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("None (password by email)")
+	if len(m.selectedSSHKeys) > 0 {
+		sshKeysValue = lipgloss.NewStyle().Foreground(successColor).Render(m.selectedSSHKeysLabel())
+	}
+	s.WriteString(fmt.Sprintf("%s %s\n", sshKeysLabelStyle.Render("SSH Keys:"), sshKeysValue))
+	if m.inputIndex == fieldSSHKeys {
+		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to pick keys from your account")))
+	}
+	s.WriteString("\n")
+
+	// User data field (cloud-init)
+	userDataLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
+	if m.inputIndex == fieldUserData {
+		userDataLabelStyle = userDataLabelStyle.Foreground(primaryColor).Bold(true)
+	}
+	userDataValue := lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("None")
+	if m.userData != "" {
+		userDataValue = lipgloss.NewStyle().Foreground(successColor).Render(m.userDataLabel())
+	}
+	s.WriteString(fmt.Sprintf("%s %s\n", userDataLabelStyle.Render("User Data:"), userDataValue))
+	if m.inputIndex == fieldUserData {
+		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("Press Enter to load a cloud-init file or template, or edit inline")))
 	}
 	s.WriteString("\n")
 
 	// Tags field
 	tagsLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
-	if m.inputIndex == 4 {
+	if m.inputIndex == fieldTags {
 		tagsLabelStyle = tagsLabelStyle.Foreground(primaryColor).Bold(true)
 	}
 	s.WriteString(fmt.Sprintf("%s %s\n", tagsLabelStyle.Render("Tags:"), m.tagsInput.View()))
-	if m.inputIndex == 4 {
+	if m.inputIndex == fieldTags {
 		s.WriteString(fmt.Sprintf("   %s\n", lipgloss.NewStyle().Foreground(mutedColor).Italic(true).Render("comma-separated, e.g., web,production")))
 	}
 	s.WriteString("\n")
 
+	// Project (from :project or the context's default_project)
+	projectLabelStyle := lipgloss.NewStyle().Width(20).Foreground(mutedColor)
+	s.WriteString(fmt.Sprintf("%s %s\n\n", projectLabelStyle.Render("Project:"), lipgloss.NewStyle().Foreground(successColor).Render(m.createProjectLabel())))
+
 	if m.loading {
 		s.WriteString(fmt.Sprintf("  %s Creating droplet...\n\n", m.spinner.View()))
 	}
@@ -4030,6 +4844,10 @@ func (m model) renderSelectionView() string {
 		title = headerStyle.Render("💾 Select Size")
 	} else if m.selectingImage {
 		title = headerStyle.Render("🖼️  Select Image")
+	} else if m.selectingVPC {
+		title = headerStyle.Render(fmt.Sprintf("🔒 Select VPC (%s)", m.selectedRegionSlug))
+	} else if m.selectingSSHKeys {
+		title = headerStyle.Render(fmt.Sprintf("🔑 Select SSH Keys (%d selected)", len(m.selectedSSHKeys)))
 	}
 	s.WriteString(title)
 	s.WriteString("\n\n")
@@ -4039,6 +4857,9 @@ func (m model) renderSelectionView() string {
 	s.WriteString("\n\n")
 
 	helpText := helpStyle.Render("[↑/↓] Navigate  [enter] Select  [esc] Cancel")
+	if m.selectingSSHKeys {
+		helpText = helpStyle.Render("[↑/↓] Navigate  [space] Toggle  [enter] Done")
+	}
 	s.WriteString(helpText)
 	s.WriteString("\n")
 
@@ -4148,6 +4969,62 @@ func (m model) renderDropletDetails() string {
 		}{"🏷️  Tags:", strings.Join(tagValues, " ")})
 	}
 
+	// User data is write-only in the API, so it's only known for droplets created here
+	userDataValue := "Unknown (not returned by the API)"
+	if source, ok := m.userDataDroplets[d.ID]; ok {
+		userDataValue = "None"
+		if source != "" {
+			userDataValue = "Provided: " + source
+		}
+	}
+	details = append(details, struct {
+		label string
+		value string
+	}{"📜 User data:", userDataValue})
+
+	// Firewalls and whether SSH is reachable from here
+	firewallNames, sshStatus := m.renderDropletFirewalls(*d)
+	details = append(details, struct {
+		label string
+		value string
+	}{"🛡️  Firewalls:", firewallNames}, struct {
+		label string
+		value string
+	}{"🔑 SSH (22):", sshStatus})
+
+	// VPC and its IP range
+	if d.VPCUUID != "" {
+		vpcValue := d.VPCUUID
+		if vpc := m.vpcByID(d.VPCUUID); vpc != nil {
+			vpcValue = fmt.Sprintf("%s (%s)", vpc.Name, vpc.IPRange)
+		}
+		details = append(details, struct {
+			label string
+			value string
+		}{"🔒 VPC:", vpcValue})
+	}
+
+	// Load balancers the droplet is behind
+	lbs := m.loadBalancersForDroplet(*d)
+	if len(lbs) > 0 {
+		lbNames := make([]string, len(lbs))
+		for i, lb := range lbs {
+			lbNames[i] = fmt.Sprintf("%s (%s)", lb.Name, lb.IP)
+		}
+		details = append(details, struct {
+			label string
+			value string
+		}{"⚖️  Load Bal.:", strings.Join(lbNames, ", ")})
+	}
+
+	// Attached volumes
+	if len(d.VolumeIDs) > 0 {
+		details = append(details, struct {
+			label string
+			value string
+		}{"💽 Volumes:", strings.Join(m.attachedVolumeNames(*d), ", ")})
+	}
+
 	// Render details - dynamic width based on terminal size
 	detailsBoxWidth := min(m.width-4, 70)
 	if detailsBoxWidth < 50 {
@@ -4249,10 +5126,14 @@ func (m model) renderDropletDetails() string {
 	s.WriteString("\n\n")
 
 	// Show SSH option if droplet is active and has IP addresses (publicIP and privateIP already declared above)
-	helpText := helpStyle.Render("[esc/enter] Back  [q] Quit")
+	help := "[esc/enter] Back"
 	if d.Status == "active" && (publicIP != "" || privateIP != "") {
-		helpText = helpStyle.Render("[esc/enter] Back  [s] SSH  [q] Quit")
+		help += "  [s] SSH"
+	}
+	if len(lbs) > 0 {
+		help += "  [l] Load Balancers"
 	}
+	helpText := helpStyle.Render(help + "  [q] Quit")
 	s.WriteString(helpText)
 	s.WriteString("\n")
 
@@ -4633,440 +5514,89 @@ func (m model) renderBillingDetails() string {
 	return s.String()
 }
 
-func loadDroplets(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{PerPage: 200}
-		droplets, _, err := client.Droplets.List(ctx, opt)
+func loadDroplets(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		droplets, err := fetchDroplets(context.Background(), client, reportProgress(progressChan, client, "droplets"))
 		if err != nil {
 			return errMsg(err)
 		}
 		return dropletsLoadedMsg(droplets)
-	}
+	})
 }
 
-func loadClusters(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{PerPage: 200}
-		clusters, _, err := client.Kubernetes.List(ctx, opt)
+func loadClusters(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		clusters, err := fetchClusters(context.Background(), client, reportProgress(progressChan, client, "clusters"))
 		if err != nil {
 			return errMsg(err)
 		}
 		return clustersLoadedMsg(clusters)
-	}
-}
-
-func loadClusterResources(client *godo.Client, cluster *godo.KubernetesCluster, resourceType string, namespace string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-
-		// Get kubeconfig for the cluster
-		kubeconfigResp, _, err := client.Kubernetes.GetKubeConfig(ctx, cluster.ID)
-		if err != nil {
-			return errMsg(fmt.Errorf("failed to get kubeconfig: %v", err))
-		}
-
-		// Kubeconfig is already bytes, no need to decode
-		kubeconfigBytes := kubeconfigResp.KubeconfigYAML
-
-		// Parse kubeconfig
-		config, err := clientcmd.Load(kubeconfigBytes)
-		if err != nil {
-			return errMsg(fmt.Errorf("failed to parse kubeconfig: %v", err))
-		}
-
-		// Create client config
-		clientConfig := clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{})
-		restConfig, err := clientConfig.ClientConfig()
-		if err != nil {
-			return errMsg(fmt.Errorf("failed to create client config: %v", err))
-		}
-
-		// Create Kubernetes client
-		k8sClient, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return errMsg(fmt.Errorf("failed to create k8s client: %v", err))
-		}
-
-		// Fetch resources based on type
-		resources := []map[string]interface{}{}
-
-		// Determine namespace - empty string means all namespaces
-		ns := namespace
-		if ns == "" {
-			ns = metav1.NamespaceAll
-		}
-
-		switch resourceType {
-		case "deployments":
-			deployments, err := k8sClient.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, d := range deployments.Items {
-					readyReplicas := d.Status.ReadyReplicas
-					replicas := d.Status.Replicas
-					age := "N/A"
-					if !d.CreationTimestamp.IsZero() {
-						duration := time.Since(d.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":      d.Name,
-						"namespace": d.Namespace,
-						"ready":     fmt.Sprintf("%d/%d", readyReplicas, replicas),
-						"upToDate":  fmt.Sprintf("%d", d.Status.UpdatedReplicas),
-						"available": fmt.Sprintf("%d", d.Status.AvailableReplicas),
-						"age":       age,
-					})
-				}
-			}
-		case "daemonsets":
-			daemonsets, err := k8sClient.AppsV1().DaemonSets(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, ds := range daemonsets.Items {
-					readyReplicas := ds.Status.NumberReady
-					desiredReplicas := ds.Status.DesiredNumberScheduled
-					age := "N/A"
-					if !ds.CreationTimestamp.IsZero() {
-						duration := time.Since(ds.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":      ds.Name,
-						"namespace": ds.Namespace,
-						"ready":     fmt.Sprintf("%d/%d", readyReplicas, desiredReplicas),
-						"current":   fmt.Sprintf("%d", ds.Status.CurrentNumberScheduled),
-						"age":       age,
-					})
-				}
-			}
-		case "statefulsets":
-			statefulsets, err := k8sClient.AppsV1().StatefulSets(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, sts := range statefulsets.Items {
-					readyReplicas := sts.Status.ReadyReplicas
-					replicas := *sts.Spec.Replicas
-					age := "N/A"
-					if !sts.CreationTimestamp.IsZero() {
-						duration := time.Since(sts.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":      sts.Name,
-						"namespace": sts.Namespace,
-						"ready":     fmt.Sprintf("%d/%d", readyReplicas, replicas),
-						"age":       age,
-					})
-				}
-			}
-		case "pvc":
-			pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, pvc := range pvcs.Items {
-					age := "N/A"
-					if !pvc.CreationTimestamp.IsZero() {
-						duration := time.Since(pvc.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					status := string(pvc.Status.Phase)
-					capacity := "N/A"
-					if len(pvc.Status.Capacity) > 0 {
-						if storage, ok := pvc.Status.Capacity["storage"]; ok {
-							capacity = storage.String()
-						}
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":      pvc.Name,
-						"namespace": pvc.Namespace,
-						"status":    status,
-						"capacity":  capacity,
-						"age":       age,
-					})
-				}
-			}
-		case "configmaps":
-			configmaps, err := k8sClient.CoreV1().ConfigMaps(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, cm := range configmaps.Items {
-					age := "N/A"
-					if !cm.CreationTimestamp.IsZero() {
-						duration := time.Since(cm.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":      cm.Name,
-						"namespace": cm.Namespace,
-						"data":      fmt.Sprintf("%d", len(cm.Data)),
-						"age":       age,
-					})
-				}
-			}
-		case "secrets":
-			secrets, err := k8sClient.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, sec := range secrets.Items {
-					age := "N/A"
-					if !sec.CreationTimestamp.IsZero() {
-						duration := time.Since(sec.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					secretType := string(sec.Type)
-					resources = append(resources, map[string]interface{}{
-						"name":      sec.Name,
-						"namespace": sec.Namespace,
-						"type":      secretType,
-						"data":      fmt.Sprintf("%d", len(sec.Data)),
-						"age":       age,
-					})
-				}
-			}
-		case "pods":
-			pods, err := k8sClient.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, p := range pods.Items {
-					ready := 0
-					total := len(p.Spec.Containers)
-					for _, cs := range p.Status.ContainerStatuses {
-						if cs.Ready {
-							ready++
-						}
-					}
-					age := "N/A"
-					if !p.CreationTimestamp.IsZero() {
-						duration := time.Since(p.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":      p.Name,
-						"namespace": p.Namespace,
-						"ready":     fmt.Sprintf("%d/%d", ready, total),
-						"status":    string(p.Status.Phase),
-						"restarts":  fmt.Sprintf("%d", p.Status.ContainerStatuses[0].RestartCount),
-						"age":       age,
-					})
-				}
-			}
-		case "services":
-			services, err := k8sClient.CoreV1().Services(ns).List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, s := range services.Items {
-					age := "N/A"
-					if !s.CreationTimestamp.IsZero() {
-						duration := time.Since(s.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					externalIP := "<none>"
-					if len(s.Status.LoadBalancer.Ingress) > 0 {
-						externalIP = s.Status.LoadBalancer.Ingress[0].IP
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":       s.Name,
-						"namespace":  s.Namespace,
-						"type":       string(s.Spec.Type),
-						"clusterIP":  s.Spec.ClusterIP,
-						"externalIP": externalIP,
-						"age":        age,
-					})
-				}
-			}
-		case "nodes":
-			nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, n := range nodes.Items {
-					age := "N/A"
-					if !n.CreationTimestamp.IsZero() {
-						duration := time.Since(n.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					status := "NotReady"
-					for _, condition := range n.Status.Conditions {
-						if condition.Type == "Ready" && condition.Status == "True" {
-							status = "Ready"
-							break
-						}
-					}
-					roles := "<none>"
-					if len(n.Labels["node-role.kubernetes.io/master"]) > 0 {
-						roles = "master"
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":    n.Name,
-						"status":  status,
-						"roles":   roles,
-						"age":     age,
-						"version": n.Status.NodeInfo.KubeletVersion,
-					})
-				}
-			}
-		case "namespaces":
-			namespaces, err := k8sClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
-			if err == nil {
-				for _, ns := range namespaces.Items {
-					age := "N/A"
-					if !ns.CreationTimestamp.IsZero() {
-						duration := time.Since(ns.CreationTimestamp.Time)
-						if duration.Hours() < 24 {
-							age = fmt.Sprintf("%.0fh", duration.Hours())
-						} else {
-							age = fmt.Sprintf("%.0fd", duration.Hours()/24)
-						}
-					}
-					status := "Active"
-					if ns.Status.Phase != "" {
-						status = string(ns.Status.Phase)
-					}
-					resources = append(resources, map[string]interface{}{
-						"name":   ns.Name,
-						"status": status,
-						"age":    age,
-					})
-				}
-			}
-		}
-
-		return clusterResourcesLoadedMsg{
-			resourceType: resourceType,
-			resources:    resources,
-		}
-	}
+	})
 }
 
 func loadAccountInfo(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
+	return withClient(client, func() tea.Msg {
 		ctx := context.Background()
 		account, _, err := client.Account.Get(ctx)
 		if err != nil {
 			return errMsg(err)
 		}
 		return accountInfoMsg{account: account}
-	}
+	})
 }
 
-func loadRegions(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{PerPage: 200}
-		regions, _, err := client.Regions.List(ctx, opt)
+func loadRegions(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		regions, err := fetchRegions(context.Background(), client, reportProgress(progressChan, client, "regions"))
 		if err != nil {
 			return errMsg(err)
 		}
 		return regionsLoadedMsg(regions)
-	}
+	})
 }
 
-func loadSizes(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{PerPage: 200}
-		sizes, _, err := client.Sizes.List(ctx, opt)
+func loadSizes(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		sizes, err := fetchSizes(context.Background(), client, reportProgress(progressChan, client, "sizes"))
 		if err != nil {
 			return errMsg(err)
 		}
 		return sizesLoadedMsg(sizes)
-	}
+	})
 }
 
 func loadBalance(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		balance, _, err := client.Balance.Get(ctx)
+	return withClient(client, func() tea.Msg {
+		balance, err := fetchBalance(context.Background(), client)
 		if err != nil {
 			return errMsg(err)
 		}
 		return balanceLoadedMsg(balance)
-	}
+	})
 }
 
-func loadInvoices(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{Page: 1, PerPage: 50}
-		var allInvoices []godo.InvoiceListItem
-
-		// Handle pagination to get all invoices
-		for {
-			invoiceList, resp, err := client.Invoices.List(ctx, opt)
-			if err != nil {
-				return errMsg(err)
-			}
-
-			if invoiceList != nil {
-				// Add invoices from current page
-				allInvoices = append(allInvoices, invoiceList.Invoices...)
-
-				// Also include the preview if available (only on first page)
-				if opt.Page == 1 && invoiceList.InvoicePreview.InvoiceUUID != "" {
-					allInvoices = append([]godo.InvoiceListItem{invoiceList.InvoicePreview}, allInvoices...)
-				}
-			}
-
-			// Check if there are more pages
-			if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
-				break
-			}
-
-			// Get next page
-			page, err := resp.Links.CurrentPage()
-			if err != nil {
-				break
-			}
-			opt.Page = page + 1
+func loadInvoices(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		invoices, err := fetchInvoices(context.Background(), client, reportProgress(progressChan, client, "invoices"))
+		if err != nil {
+			return errMsg(err)
 		}
-
-		return invoicesLoadedMsg(allInvoices)
-	}
+		return invoicesLoadedMsg(invoices)
+	})
 }
 
-func loadBillingHistory(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{PerPage: 100}
-		history, _, err := client.BillingHistory.List(ctx, opt)
+func loadBillingHistory(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		history, err := fetchBillingHistory(context.Background(), client, reportProgress(progressChan, client, "billing history"))
 		if err != nil {
 			return errMsg(err)
 		}
 		return billingHistoryLoadedMsg(history)
-	}
+	})
 }
 
 func loadInvoiceDetails(client *godo.Client, invoiceUUID string) tea.Cmd {
-	return func() tea.Msg {
+	return withClient(client, func() tea.Msg {
 		ctx := context.Background()
 		opt := &godo.ListOptions{PerPage: 100}
 		invoice, _, err := client.Invoices.Get(ctx, invoiceUUID, opt)
@@ -5074,7 +5604,7 @@ func loadInvoiceDetails(client *godo.Client, invoiceUUID string) tea.Cmd {
 			return errMsg(err)
 		}
 		return invoiceDetailsLoadedMsg(invoice)
-	}
+	})
 }
 
 // normalizeCPUValue converts various CPU metric formats to a 0-100% percentage
@@ -5124,7 +5654,7 @@ func normalizeCPUValue(rawValue float64) float64 {
 }
 
 func loadDropletMetrics(client *godo.Client, dropletID int) tea.Cmd {
-	return func() tea.Msg {
+	return withClient(client, func() tea.Msg {
 		ctx := context.Background()
 		now := time.Now()
 		start := now.Add(-1 * time.Hour) // Get metrics for the last hour
@@ -5279,14 +5809,12 @@ func loadDropletMetrics(client *godo.Client, dropletID int) tea.Cmd {
 		}
 
 		return dropletMetricsLoadedMsg(metrics)
-	}
+	})
 }
 
-func loadImages(client *godo.Client) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
-		opt := &godo.ListOptions{PerPage: 200}
-		images, _, err := client.Images.List(ctx, opt)
+func loadImages(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		images, err := fetchImages(context.Background(), client, reportProgress(progressChan, client, "images"))
 		if err != nil {
 			return errMsg(err)
 		}
@@ -5379,67 +5907,46 @@ func loadImages(client *godo.Client) tea.Cmd {
 				validImages = append(validImages, img)
 			}
 		}
+
+		// Private snapshots and custom images have no slug and are selected by ID
+		userImages, err := fetchUserImages(context.Background(), client, reportProgress(progressChan, client, "user images"))
+		if err != nil {
+			return errMsg(err)
+		}
+		validImages = append(validImages, userImages...)
 		return imagesLoadedMsg(validImages)
-	}
+	})
 }
 
 func createDroplet(client *godo.Client, m model) tea.Cmd {
 	return func() tea.Msg {
-		name := strings.TrimSpace(m.nameInput.Value())
-		region := m.selectedRegionSlug
-		size := m.selectedSizeSlug
-		image := m.selectedImageSlug
-		tagsStr := strings.TrimSpace(m.tagsInput.Value())
-
-		if name == "" || region == "" || size == "" || image == "" {
-			return errMsg(fmt.Errorf("name, region, size, and image are required"))
-		}
-
-		var tags []string
-		if tagsStr != "" {
-			tagList := strings.Split(tagsStr, ",")
-			for _, tag := range tagList {
-				tags = append(tags, strings.TrimSpace(tag))
-			}
-		}
-
-		createRequest := &godo.DropletCreateRequest{
-			Name:   name,
-			Region: region,
-			Size:   size,
-			Image: godo.DropletCreateImage{
-				Slug: image,
-			},
-			IPv6: true,
-			Tags: tags,
-		}
-
-		ctx := context.Background()
-		droplet, resp, err := client.Droplets.Create(ctx, createRequest)
-		if err != nil {
-			// Try to extract more detailed error message from response
-			var errMsgText string
-			if resp != nil && resp.Body != nil {
-				// The godo library should include error details in the error message
-				errMsgText = err.Error()
-			} else {
-				errMsgText = err.Error()
-			}
-			// Add context about what was being created
-			return errMsg(fmt.Errorf("failed to create droplet: %s (region: %s, size: %s, image: %s)",
-				errMsgText, region, size, image))
+		spec := dropletSpec{
+			Name:     strings.TrimSpace(m.nameInput.Value()),
+			Region:   m.selectedRegionSlug,
+			Size:     m.selectedSizeSlug,
+			Image:    m.selectedImageSlug,
+			VPC:      m.selectedVPCUUID,
+			Tags:     parseTags(m.tagsInput.Value()),
+			SSHKeys:  m.selectedSSHKeys,
+			Project:  m.createProject(),
+			UserData: m.userData,
+		}
+
+		droplet, err := createDropletFromSpec(context.Background(), client, spec)
+		var moveErr *projectMoveError
+		if err != nil && !errors.As(err, &moveErr) {
+			return errMsg(err)
 		}
 
 		time.Sleep(1 * time.Second)
-		return dropletCreatedMsg(droplet)
+		// err is the move failure here, if any
+		return dropletCreatedMsg{droplet: droplet, warning: err}
 	}
 }
 
 func deleteDroplet(client *godo.Client, id int) tea.Cmd {
 	return func() tea.Msg {
-		ctx := context.Background()
-		_, err := client.Droplets.Delete(ctx, id)
-		if err != nil {
+		if err := destroyDroplet(context.Background(), client, id); err != nil {
 			return errMsg(err)
 		}
 		time.Sleep(500 * time.Millisecond)
@@ -5449,38 +5956,22 @@ func deleteDroplet(client *godo.Client, id int) tea.Cmd {
 
 // startSSHTerminalView starts the SSH terminal view
 func (m *model) startSSHTerminalView(ip, name string) (tea.Model, tea.Cmd) {
-	m.sshTerminalActive = true
-	m.sshTerminalHost = name
-	m.sshTerminalIP = ip
-	// Initialize terminal emulator - this is the SINGLE SOURCE OF TRUTH for rendering
-	// Initialize raw output buffer if needed
-	if m.sshTerminalRawOutput == nil {
-		m.sshTerminalRawOutput = &strings.Builder{}
-	} else {
-		m.sshTerminalRawOutput.Reset()
-	}
-
-	// CRITICAL: Initialize terminal emulator with correct size
-	// Calculate available display size (must match what we'll render)
-	availableRows := m.height - getTopPadding() - 6 // Header + padding + help text
-	if availableRows < 5 {
-		availableRows = 5
-	}
-	availableCols := m.width - 4 // Account for border and padding
-	if availableCols < 40 {
-		availableCols = 40
-	}
-	// Create terminal buffer with exact display dimensions
-	// This ensures cursor positioning and screen updates work correctly
-	m.sshTerminalEmulator = terminal.NewTerminalBuffer(availableCols, availableRows)
-	m.sshTerminalParser = govte.NewParser()
+	// CRITICAL: Initialize terminal emulator with the exact display size
+	s := newTerminalSession(m.terminalSize())
+	s.title = fmt.Sprintf("🔌 Connected to: %s", name)
+	s.detail = fmt.Sprintf("IP: %s", ip)
+	s.reminder = fmt.Sprintf("Host: %s", name)
+	s.exitLabel = "Exit SSH"
+	s.closePrompt = fmt.Sprintf("Close SSH connection to %s?", name)
+	s.connecting = fmt.Sprintf("🔌 Connecting to %s (%s)...", name, ip)
+	m.terminal = s
 
 	// Start SSH connection - the started message will trigger output polling
-	return m, startSSHTerminal(ip, name, m.sshOutputChan)
+	return m, startSSHTerminal(s, ip, m.terminalOutputChan)
 }
 
 // startSSHTerminal starts an SSH connection in a PTY
-func startSSHTerminal(ip, name string, outputChan chan<- tea.Msg) tea.Cmd {
+func startSSHTerminal(s *terminalSession, ip string, outputChan chan<- tea.Msg) tea.Cmd {
 	return func() tea.Msg {
 		// Create SSH command with options to avoid hanging
 		cmd := exec.Command("ssh",
@@ -5506,21 +5997,20 @@ func startSSHTerminal(ip, name string, outputChan chan<- tea.Msg) tea.Cmd {
 		cmd.Env = append(os.Environ(), "TERM="+termType)
 
 		// Create PTY with proper initial size
-		// The size will be updated when we get the actual window size
-		// Use reasonable defaults that will be updated immediately
+		// The size is set to the display area as soon as the started message arrives
 		ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{
-			Rows: 24, // Will be updated on first WindowSizeMsg
-			Cols: 80, // Will be updated on first WindowSizeMsg
+			Rows: 24,
+			Cols: 80,
 		})
 		if err != nil {
-			return errMsg(fmt.Errorf("failed to start SSH: %v", err))
+			return terminalClosedMsg{session: s, err: fmt.Errorf("failed to start SSH: %v", err)}
 		}
 
 		// Start reading output in a goroutine immediately
 		go func() {
 			defer func() {
 				if r := recover(); r != nil {
-					outputChan <- errMsg(fmt.Errorf("panic in SSH reader: %v", r))
+					outputChan <- terminalClosedMsg{session: s, err: fmt.Errorf("panic in SSH reader: %v", r)}
 				}
 			}()
 
@@ -5530,289 +6020,72 @@ func startSSHTerminal(ip, name string, outputChan chan<- tea.Msg) tea.Cmd {
 				n, err := ptmx.Read(buf)
 				if err != nil {
 					if err == io.EOF {
-						outputChan <- sshTerminalOutputMsg("\r\n[Connection closed]\r\n")
-						outputChan <- sshTerminalClosedMsg{}
+						outputChan <- terminalOutputMsg{session: s, data: "\r\n[Connection closed]\r\n"}
+						outputChan <- terminalClosedMsg{session: s}
 						return
 					}
-					outputChan <- errMsg(fmt.Errorf("SSH read error: %v", err))
+					outputChan <- terminalClosedMsg{session: s, err: fmt.Errorf("SSH read error: %v", err)}
 					return
 				}
 				if n > 0 {
 					// Send output immediately - preserve ALL bytes (ANSI sequences, control chars, etc.)
-					outputChan <- sshTerminalOutputMsg(string(buf[:n]))
+					outputChan <- terminalOutputMsg{session: s, data: string(buf[:n])}
 				}
 			}
 		}()
 
-		// Return the PTY and command for the model to store
-		return sshTerminalStartedMsg{ptmx: ptmx, cmd: cmd, ip: ip, name: name}
+		return terminalStartedMsg{session: s, backend: &terminalBackend{
+			stdin: ptmx,
+			resize: func(cols, rows int) {
+				pty.Setsize(ptmx, &pty.Winsize{Rows: uint16(rows), Cols: uint16(cols)})
+			},
+			close: func() {
+				if cmd.Process != nil {
+					cmd.Process.Kill()
+				}
+				ptmx.Close()
+			},
+		}}
 	}
 }
 
-// sshTerminalStartedMsg is sent when SSH terminal starts
-type sshTerminalStartedMsg struct {
-	ptmx *os.File
-	cmd  *exec.Cmd
-	ip   string
-	name string
-}
-
-// waitForSSHOutput waits for messages from the SSH output channel
-// Uses a continuous ticker to poll the channel non-blockingly
-func waitForSSHOutput(outputChan <-chan tea.Msg) tea.Cmd {
-	return tea.Tick(50*time.Millisecond, func(t time.Time) tea.Msg {
-		select {
-		case msg := <-outputChan:
-			// Got a message, return it
-			return msg
-		default:
-			// No message yet, return time to keep ticker running
-			return t
-		}
-	})
-}
-
-// sshTerminalClosedMsg is sent when SSH connection closes
-type sshTerminalClosedMsg struct{}
+func main() {
+	contextFlag := flag.String("context", "", "named context from config.yaml to use")
+	flag.Usage = func() { printUsage(os.Stderr) }
+	flag.Parse()
 
-// updateSSHTerminal handles keyboard input for SSH terminal
-func (m *model) updateSSHTerminal(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Handle exit confirmation dialog
-	if m.sshTerminalConfirmExit {
-		switch msg := msg.(type) {
-		case tea.KeyMsg:
-			switch msg.String() {
-			case "y", "Y":
-				// Confirm exit
-				m.sshTerminalConfirmExit = false
-				m.closeSSHTerminal()
-				return m, nil
-			case "n", "N", "esc":
-				// Cancel exit
-				m.sshTerminalConfirmExit = false
-				return m, nil
-			default:
-				return m, nil
-			}
-		default:
-			return m, nil
-		}
-	}
+	// Load config.yaml - errors are reported in the TUI rather than aborting
+	cfg, cfgErr := loadConfig()
+	configTopPadding = cfg.Settings.TopPadding
 
-	// Forward all other input to SSH
-	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		return m.handleSSHInput(keyMsg)
+	// Subcommands run non-interactively, for scripts and CI
+	if args := flag.Args(); len(args) > 0 {
+		os.Exit(runCLI(args, cfg, cfgErr, *contextFlag))
 	}
 
-	return m, nil
-}
-
-// writeToPTY writes bytes to the SSH PTY, handling errors gracefully
-func (m *model) writeToPTY(b []byte) error {
-	if m.sshTerminalPTY == nil {
-		return fmt.Errorf("PTY is nil")
-	}
-	_, err := m.sshTerminalPTY.Write(b)
+	contextName, activeContext, err := cfg.resolveContext(*contextFlag)
 	if err != nil {
-		// PTY write failed - connection might be closed
-		m.err = fmt.Errorf("failed to write to PTY: %v", err)
-		m.closeSSHTerminal()
-		return err
-	}
-	return nil
-}
-
-// handleSSHInput forwards keyboard input to the SSH session
-func (m *model) handleSSHInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	// Handle Esc key - show exit confirmation dialog
-	if msg.Type == tea.KeyEsc || msg.String() == "esc" {
-		if !m.sshTerminalConfirmExit {
-			m.sshTerminalConfirmExit = true
-			return m, nil
-		}
-		// If already in confirmation, cancel it
-		m.sshTerminalConfirmExit = false
-		return m, nil
-	}
-
-	// NO LOCAL SCROLLING - terminal emulator IS the screen buffer
-	// All keys (including pageup/pagedown) are forwarded to SSH
-	// The terminal emulator handles its own scrollback internally
-
-	// All other keys are forwarded to SSH
-	// This includes: regular typing, Enter, Backspace, Tab, arrows, function keys, etc.
-
-	if m.sshTerminalPTY == nil {
-		// PTY not ready yet - ignore input
-		return m, nil
-	}
-
-	var keyBytes []byte
-
-	// CRITICAL: Check for special control keys FIRST
-	// These must be handled before checking runes
-	switch msg.Type {
-	case tea.KeyEnter:
-		// Enter key - send carriage return to execute command
-		keyBytes = []byte("\r")
-	case tea.KeyBackspace:
-		// Backspace - send DEL (0x7f) for proper terminal behavior
-		// Note: \b (0x08) is backspace, but terminals expect DEL (0x7f)
-		keyBytes = []byte{0x7f}
-	case tea.KeyTab:
-		keyBytes = []byte("\t")
-	case tea.KeySpace:
-		keyBytes = []byte(" ")
-	case tea.KeyCtrlC:
-		// Ctrl+C - send SIGINT (ASCII 3) to terminate remote process
-		keyBytes = []byte{3}
-	case tea.KeyCtrlD:
-		// Ctrl+D - send EOF (ASCII 4) to close input stream
-		keyBytes = []byte{4}
-	case tea.KeyCtrlZ:
-		// Ctrl+Z - send SUSP (ASCII 26) to suspend process
-		keyBytes = []byte{26}
-	case tea.KeyUp, tea.KeyDown, tea.KeyLeft, tea.KeyRight:
-		// Arrow keys - send ANSI escape sequences
-		// These are critical for command history and interactive programs like htop
-		keyBytes = getANSISequence(msg.Type)
-	case tea.KeyDelete:
-		// Delete key - send ANSI sequence
-		keyBytes = getANSISequence(msg.Type)
-	case tea.KeyF1, tea.KeyF2, tea.KeyF3, tea.KeyF4, tea.KeyF5, tea.KeyF6,
-		tea.KeyF7, tea.KeyF8, tea.KeyF9, tea.KeyF10, tea.KeyF11, tea.KeyF12:
-		// Function keys - needed for htop, vim, etc.
-		keyBytes = getFunctionKeySequence(msg.Type)
-	default:
-		// Regular character input - check for runes first
-		if len(msg.Runes) > 0 {
-			// Convert runes to UTF-8 bytes
-			// This handles all printable characters: letters, numbers, symbols, etc.
-			keyBytes = []byte(string(msg.Runes))
-		} else {
-			// Fallback: try string representation for single characters
-			keyStr := msg.String()
-			if keyStr != "" && len(keyStr) == 1 {
-				keyBytes = []byte(keyStr)
-			} else if msg.Type >= tea.KeyCtrlA && msg.Type <= tea.KeyCtrlZ {
-				// Ctrl+letter combinations (Ctrl+A = 1, Ctrl+B = 2, etc.)
-				keyBytes = []byte{byte(msg.Type - tea.KeyCtrlA + 1)}
-			}
-		}
-	}
-
-	// Write to PTY if we have bytes to send
-	if len(keyBytes) > 0 {
-		if err := m.writeToPTY(keyBytes); err != nil {
-			// Error already handled in writeToPTY (closes terminal, sets error)
-			return m, nil
+		fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+		if cfgErr != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", cfgErr)
 		}
+		os.Exit(1)
 	}
 
-	return m, nil
-}
-
-// closeSSHTerminal closes the SSH terminal connection
-func (m *model) closeSSHTerminal() {
-	if m.sshTerminalCmd != nil && m.sshTerminalCmd.Process != nil {
-		m.sshTerminalCmd.Process.Kill()
-	}
-	if m.sshTerminalPTY != nil {
-		m.sshTerminalPTY.Close()
-	}
-	m.sshTerminalActive = false
-	m.sshTerminalEmulator = nil
-	m.sshTerminalParser = nil
-	m.sshTerminalPTY = nil
-	m.sshTerminalCmd = nil
-	if m.sshTerminalRawOutput != nil {
-		m.sshTerminalRawOutput.Reset()
-	}
-	m.sshTerminalHost = ""
-	m.sshTerminalIP = ""
-	m.sshTerminalConfirmExit = false
-	// Clear any pending messages from the channel
-	for {
-		select {
-		case <-m.sshOutputChan:
-		default:
-			return
+	token, tokenSource, err := activeContext.token()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Error: context %s: %v\n", contextName, err)
+		if len(activeContext.tokenProviders()) > 1 {
+			fmt.Fprintf(os.Stderr, "Please set it with: export DO_TOKEN=your_token_here (or run: doctl auth init)\n")
 		}
-	}
-}
-
-// getANSISequence returns ANSI escape sequence for special keys
-func getANSISequence(key tea.KeyType) []byte {
-	switch key {
-	case tea.KeyUp:
-		return []byte("\x1b[A")
-	case tea.KeyDown:
-		return []byte("\x1b[B")
-	case tea.KeyRight:
-		return []byte("\x1b[C")
-	case tea.KeyLeft:
-		return []byte("\x1b[D")
-	case tea.KeyHome:
-		return []byte("\x1b[H")
-	case tea.KeyEnd:
-		return []byte("\x1b[F")
-	case tea.KeyPgUp:
-		return []byte("\x1b[5~")
-	case tea.KeyPgDown:
-		return []byte("\x1b[6~")
-	case tea.KeyDelete:
-		return []byte("\x1b[3~")
-	default:
-		return nil
-	}
-}
-
-// getFunctionKeySequence returns ANSI escape sequence for function keys (F1-F12)
-func getFunctionKeySequence(key tea.KeyType) []byte {
-	switch key {
-	case tea.KeyF1:
-		return []byte("\x1bOP")
-	case tea.KeyF2:
-		return []byte("\x1bOQ")
-	case tea.KeyF3:
-		return []byte("\x1bOR")
-	case tea.KeyF4:
-		return []byte("\x1bOS")
-	case tea.KeyF5:
-		return []byte("\x1b[15~")
-	case tea.KeyF6:
-		return []byte("\x1b[17~")
-	case tea.KeyF7:
-		return []byte("\x1b[18~")
-	case tea.KeyF8:
-		return []byte("\x1b[19~")
-	case tea.KeyF9:
-		return []byte("\x1b[20~")
-	case tea.KeyF10:
-		return []byte("\x1b[21~")
-	case tea.KeyF11:
-		return []byte("\x1b[23~")
-	case tea.KeyF12:
-		return []byte("\x1b[24~")
-	default:
-		return nil
-	}
-}
-
-func main() {
-	token := os.Getenv("DO_TOKEN")
-	if token == "" {
-		fmt.Fprintf(os.Stderr, "❌ Error: DO_TOKEN environment variable is not set\n")
-		fmt.Fprintf(os.Stderr, "Please set it with: export DO_TOKEN=your_token_here\n")
 		os.Exit(1)
 	}
-
-	tokenSource := &TokenSource{AccessToken: token}
-	oauthClient := oauth2.NewClient(context.Background(), tokenSource)
-	client := godo.NewClient(oauthClient)
+	client := newGodoClient(token)
 
 	// Initialize and run the TUI
-	m := initialModel(client)
+	m := initialModel(client, cfg, cfgErr, contextName, activeContext)
+	m.tokenSource = tokenSource
+	m.successMsg = fmt.Sprintf("🔑 Using API token from %s", tokenSource)
 	p := tea.NewProgram(m, tea.WithAltScreen())
 
 	if err := p.Start(); err != nil {
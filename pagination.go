@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// defaultPerPage is the page size used for list calls (the API maximum is 200)
+const defaultPerPage = 200
+
+// pageProgress is called after each page with the number of items loaded so
+// far and the total reported by the API (0 if unknown)
+type pageProgress func(loaded, total int)
+
+// pageFetcher fetches a single page of a list endpoint
+type pageFetcher[T any] func(ctx context.Context, opt *godo.ListOptions) ([]T, *godo.Response, error)
+
+// listAll walks every page of a list endpoint by following resp.Links
+func listAll[T any](ctx context.Context, perPage int, fetch pageFetcher[T], progress pageProgress) ([]T, error) {
+	opt := &godo.ListOptions{Page: 1, PerPage: perPage}
+	var all []T
+
+	for {
+		items, resp, err := fetch(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if progress != nil {
+			total := 0
+			if resp != nil && resp.Meta != nil {
+				total = resp.Meta.Total
+			}
+			progress(len(all), total)
+		}
+
+		// Check if there are more pages
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		// Get next page
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			break
+		}
+		opt.Page = page + 1
+	}
+
+	return all, nil
+}
+
+// loadProgressMsg reports how many items of a resource list have loaded
+type loadProgressMsg struct {
+	client   *godo.Client // Client of the load, to drop progress of loads from before a :ctx switch
+	resource string
+	loaded   int
+	total    int
+}
+
+// reportProgress returns a pageProgress that forwards updates to the TUI.
+// Updates are dropped rather than blocking if the channel is full - the
+// final loaded message makes the count correct anyway.
+func reportProgress(progressChan chan<- tea.Msg, client *godo.Client, resource string) pageProgress {
+	if progressChan == nil {
+		return nil
+	}
+	return func(loaded, total int) {
+		select {
+		case progressChan <- loadProgressMsg{client: client, resource: resource, loaded: loaded, total: total}:
+		default:
+		}
+	}
+}
+
+// waitForLoadProgress waits for the next page progress update
+func waitForLoadProgress(progressChan <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-progressChan
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// defaultExecCommand is what pod shells run unless settings.exec_command says otherwise
+const defaultExecCommand = "/bin/sh"
+
+// podExecOptions is what a pod shell runs
+type podExecOptions struct {
+	namespace string
+	pod       string
+	container string   // "" for single-container pods
+	command   []string // Command and its arguments
+}
+
+// openPodExec opens a shell in the pod row, picking a container first if the
+// pod has several. With prompt, the command is asked for instead of using
+// the configured one.
+func (m *model) openPodExec(r map[string]interface{}, prompt bool) tea.Cmd {
+	opts := podExecOptions{
+		namespace: getMapValue(r, "namespace", ""),
+		pod:       getMapValue(r, "name", ""),
+	}
+	start := func(m *model) tea.Cmd {
+		if !prompt {
+			opts.command = strings.Fields(m.config.execCommand())
+			return m.startPodExec(opts)
+		}
+		m.input = newInputDialog("🐚 Exec in "+opts.pod, "command and arguments", m.config.execCommand(), func(m *model, value string) tea.Cmd {
+			opts.command = strings.Fields(value)
+			return m.startPodExec(opts)
+		})
+		return nil
+	}
+
+	containers, _ := r["containers"].([]string)
+	if len(containers) <= 1 {
+		if len(containers) == 1 {
+			opts.container = containers[0]
+		}
+		return start(m)
+	}
+	m.openContainerPicker(opts.pod, containers, "", func(m *model, container string) tea.Cmd {
+		opts.container = container
+		return start(m)
+	})
+	return nil
+}
+
+// startPodExec opens the terminal view on the command running in the pod
+func (m *model) startPodExec(opts podExecOptions) tea.Cmd {
+	if len(opts.command) == 0 {
+		opts.command = []string{defaultExecCommand}
+	}
+	target := opts.namespace + "/" + opts.pod
+	s := newTerminalSession(m.terminalSize())
+	s.title = fmt.Sprintf("🐚 Shell in: %s", target)
+	s.detail = fmt.Sprintf("Command: %s", strings.Join(opts.command, " "))
+	if opts.container != "" {
+		s.detail = fmt.Sprintf("Container: %s  |  %s", opts.container, s.detail)
+	}
+	s.reminder = fmt.Sprintf("Pod: %s", target)
+	s.exitLabel = "Exit shell"
+	s.closePrompt = fmt.Sprintf("Close shell in %s?", target)
+	s.connecting = fmt.Sprintf("🐚 Starting %s in %s...", opts.command[0], target)
+	m.terminal = s
+
+	// The started message will trigger output polling
+	return podExecTerminal(s, m.kubeClients, m.client, m.selectedCluster, opts, m.terminalOutputChan)
+}
+
+// podExecTerminal runs the command in the pod with a TTY, the way kubectl
+// exec -it does, over WebSockets or SPDY for clusters that don't support them
+func podExecTerminal(s *terminalSession, kubeClients *kubeClientCache, client *godo.Client, cluster *godo.KubernetesCluster, opts podExecOptions, outputChan chan<- tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+		kc, err := kubeClients.get(ctx, client, cluster)
+		if err != nil {
+			cancel()
+			return terminalClosedMsg{session: s, err: err}
+		}
+
+		req := kc.clientset.CoreV1().RESTClient().Post().
+			Resource("pods").
+			Namespace(opts.namespace).
+			Name(opts.pod).
+			SubResource("exec").
+			VersionedParams(&corev1.PodExecOptions{
+				Container: opts.container,
+				Command:   opts.command,
+				Stdin:     true,
+				Stdout:    true,
+				TTY:       true,
+			}, scheme.ParameterCodec)
+		executor, err := podExecutor(kc.restConfig, req.URL())
+		if err != nil {
+			cancel()
+			return terminalClosedMsg{session: s, err: fmt.Errorf("exec in %s: %w", opts.pod, err)}
+		}
+
+		stdin, stdinWriter := io.Pipe()
+		sizes := make(terminalSizeQueue, 1)
+		go func() {
+			// With a TTY, stderr is merged into stdout
+			err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+				Stdin:             stdin,
+				Stdout:            &terminalOutputWriter{ctx: ctx, session: s, output: outputChan},
+				Tty:               true,
+				TerminalSizeQueue: sizes,
+			})
+			if ctx.Err() != nil {
+				return // Closed by the user
+			}
+			if err != nil {
+				err = fmt.Errorf("exec in %s: %w", opts.pod, err)
+			}
+			outputChan <- terminalClosedMsg{session: s, err: err}
+		}()
+
+		var closeOnce sync.Once
+		return terminalStartedMsg{session: s, backend: &terminalBackend{
+			stdin:  stdinWriter,
+			resize: sizes.push,
+			close: func() {
+				closeOnce.Do(func() {
+					cancel()
+					stdinWriter.Close()
+					close(sizes)
+				})
+			},
+		}}
+	}
+}
+
+// podExecutor returns an executor for the exec URL that uses WebSockets,
+// falling back to SPDY if the cluster or a proxy can't upgrade to them
+func podExecutor(config *rest.Config, u *url.URL) (remotecommand.Executor, error) {
+	websocketExec, err := remotecommand.NewWebSocketExecutor(config, "GET", u.String())
+	if err != nil {
+		return nil, err
+	}
+	spdyExec, err := remotecommand.NewSPDYExecutor(config, "POST", u)
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewFallbackExecutor(websocketExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+}
+
+// terminalSizeQueue passes terminal resizes to a running exec
+type terminalSizeQueue chan remotecommand.TerminalSize
+
+// push queues a new size, replacing one that hasn't been sent yet
+func (q terminalSizeQueue) push(cols, rows int) {
+	select {
+	case <-q:
+	default:
+	}
+	q <- remotecommand.TerminalSize{Width: uint16(cols), Height: uint16(rows)}
+}
+
+// Next blocks until there is a new size, returning nil once the exec is closed
+func (q terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+	return &size
+}
+
+// terminalOutputWriter sends an exec's output to the terminal
+type terminalOutputWriter struct {
+	ctx     context.Context
+	session *terminalSession
+	output  chan<- tea.Msg
+}
+
+func (w *terminalOutputWriter) Write(p []byte) (int, error) {
+	select {
+	case w.output <- terminalOutputMsg{session: w.session, data: string(p)}:
+		return len(p), nil
+	case <-w.ctx.Done():
+		return 0, w.ctx.Err()
+	}
+}
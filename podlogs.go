@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// defaultPodLogTail is how many lines a pod log pane starts with
+const defaultPodLogTail = 500
+
+// maxPodLogLineSize is the longest log line read from a pod
+const maxPodLogLineSize = 1024 * 1024
+
+// podLogOptions is what a pod log pane streams
+type podLogOptions struct {
+	namespace  string
+	pod        string
+	container  string   // "" for single-container pods
+	containers []string // All of the pod's containers
+	previous   bool     // Logs of the previous, terminated container instance
+	timestamps bool     // Prefix lines with their RFC3339 timestamp
+	tailLines  int64    // Lines from the end to start with (0 = all)
+}
+
+// openPodLogs opens the log pane for the pod row, picking a container first
+// if the pod has several
+func (m *model) openPodLogs(r map[string]interface{}) tea.Cmd {
+	opts := podLogOptions{
+		namespace: getMapValue(r, "namespace", ""),
+		pod:       getMapValue(r, "name", ""),
+		tailLines: defaultPodLogTail,
+	}
+	opts.containers, _ = r["containers"].([]string)
+	if len(opts.containers) <= 1 {
+		if len(opts.containers) == 1 {
+			opts.container = opts.containers[0]
+		}
+		return m.startPodLogs(&opts)
+	}
+	m.openPodContainerPicker(&opts)
+	return nil
+}
+
+// openPodContainerPicker asks which container's logs to show
+func (m *model) openPodContainerPicker(opts *podLogOptions) {
+	m.openContainerPicker(opts.pod, opts.containers, opts.container, func(m *model, container string) tea.Cmd {
+		opts.container = container
+		return m.startPodLogs(opts)
+	})
+}
+
+// openContainerPicker asks which of the pod's containers to use
+func (m *model) openContainerPicker(pod string, containers []string, current string, onPick func(m *model, container string) tea.Cmd) {
+	options := make([]pickerOption, len(containers))
+	for i, c := range containers {
+		label := c
+		if c == current {
+			label += " (current)"
+		}
+		options[i] = pickerOption{label: label, value: c}
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("📦 Container of %s", pod),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			return onPick(m, option.value)
+		},
+	}
+}
+
+// startPodLogs opens the pod log pane, or restarts its stream with changed options
+func (m *model) startPodLogs(opts *podLogOptions) tea.Cmd {
+	p := m.logPane
+	if p == nil || p.handleKey == nil {
+		m.closeLogPane()
+		p = &logPane{
+			follow: true,
+			status: opts.status,
+			help:   "[c] Container  [p] Previous  [t] Timestamps  [T] Tail",
+			handleKey: func(m *model, key string) (tea.Cmd, bool) {
+				return m.handlePodLogKey(opts, key)
+			},
+		}
+		m.logPane = p
+	}
+	p.title = opts.title()
+	// Each restart streams a copy, so later option changes don't race with it
+	p.stream = podLogStream(m.kubeClients, m.client, m.selectedCluster, *opts, p.follow)
+	return startLogStream(p)
+}
+
+func (m *model) handlePodLogKey(opts *podLogOptions, key string) (tea.Cmd, bool) {
+	switch key {
+	case "c", "C":
+		if len(opts.containers) > 1 {
+			m.openPodContainerPicker(opts)
+		}
+		return nil, true
+	case "p", "P":
+		opts.previous = !opts.previous
+		return m.startPodLogs(opts), true
+	case "t":
+		opts.timestamps = !opts.timestamps
+		return m.startPodLogs(opts), true
+	case "T":
+		m.input = newInputDialog("📜 Tail lines", "lines from the end, 0 for all", strconv.FormatInt(opts.tailLines, 10), func(m *model, value string) tea.Cmd {
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 0 {
+				m.logPane.notice = fmt.Sprintf("❌ Tail lines must be a number, got %q", value)
+				return nil
+			}
+			opts.tailLines = n
+			return m.startPodLogs(opts)
+		})
+		return nil, true
+	case "f", "F":
+		// Following a pod log is a streaming request, so the stream is rebuilt either way
+		p := m.logPane
+		p.follow = !p.follow
+		if p.follow {
+			return m.startPodLogs(opts), true
+		}
+		if p.cancel != nil {
+			p.cancel()
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+func (o *podLogOptions) title() string {
+	title := fmt.Sprintf("📜 Logs: %s/%s", o.namespace, o.pod)
+	if len(o.containers) > 1 {
+		title += " [" + o.container + "]"
+	}
+	return title
+}
+
+// status describes the options in the pane's status line
+func (o *podLogOptions) status() string {
+	var parts []string
+	if o.tailLines > 0 {
+		parts = append(parts, fmt.Sprintf("tail %d", o.tailLines))
+	} else {
+		parts = append(parts, "all lines")
+	}
+	if o.previous {
+		parts = append(parts, "previous container")
+	}
+	if o.timestamps {
+		parts = append(parts, "timestamps")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// podLogStream returns a stream of the pod's logs with the options
+func podLogStream(kubeClients *kubeClientCache, client *godo.Client, cluster *godo.KubernetesCluster, opts podLogOptions, follow bool) func(ctx context.Context, emit func(line string)) error {
+	return func(ctx context.Context, emit func(line string)) error {
+		kc, err := kubeClients.get(ctx, client, cluster)
+		if err != nil {
+			return err
+		}
+		logOpts := &corev1.PodLogOptions{
+			Container:  opts.container,
+			Follow:     follow,
+			Previous:   opts.previous,
+			Timestamps: opts.timestamps,
+		}
+		if opts.tailLines > 0 {
+			logOpts.TailLines = &opts.tailLines
+		}
+		body, err := kc.clientset.CoreV1().Pods(opts.namespace).GetLogs(opts.pod, logOpts).Stream(ctx)
+		if err != nil {
+			return fmt.Errorf("logs of %s: %w", opts.pod, err)
+		}
+		defer body.Close()
+
+		scanner := bufio.NewScanner(body)
+		scanner.Buffer(make([]byte, 64*1024), maxPodLogLineSize)
+		for scanner.Scan() {
+			emit(scanner.Text())
+		}
+		if ctx.Err() != nil {
+			return nil // Stopped, not failed
+		}
+		return scanner.Err()
+	}
+}
@@ -0,0 +1,482 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// projectsLoadedMsg carries the projects and the resources of each, by project ID
+type projectsLoadedMsg struct {
+	projects  []godo.Project
+	resources map[string][]godo.ProjectResource
+}
+
+// activeProjectMsg carries the project selected with :project and the URNs of its resources
+type activeProjectMsg struct {
+	project *godo.Project
+	urns    map[string]bool
+}
+
+// projectResourceMovedMsg reports a resource moved into a project
+type projectResourceMovedMsg struct {
+	urn     string
+	project godo.Project
+	message string
+}
+
+// projectMove is a resource waiting for the projects to load before the move picker opens
+type projectMove struct {
+	urn  string
+	name string
+}
+
+func init() {
+	registerResourceView(&resourceView{
+		name:  viewProjects,
+		title: "Projects",
+		load: func(m *model) tea.Cmd {
+			return loadProjects(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.projects)
+		},
+		updateTable: (*model).updateProjectTable,
+		handleKey:   (*model).handleProjectKey,
+		keys: []keyHint{
+			{"f", "Filter by project"},
+		},
+		renderDetails: model.renderProjectDetails,
+	})
+}
+
+func loadProjects(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		ctx := context.Background()
+		projects, err := fetchProjects(ctx, client, reportProgress(progressChan, client, "projects"))
+		if err != nil {
+			return errMsg(err)
+		}
+		resources := make(map[string][]godo.ProjectResource, len(projects))
+		for _, p := range projects {
+			r, err := fetchProjectResources(ctx, client, p.ID, nil)
+			if err != nil {
+				return errMsg(fmt.Errorf("failed to list resources of project %s: %w", p.Name, err))
+			}
+			resources[p.ID] = r
+		}
+		return projectsLoadedMsg{projects: projects, resources: resources}
+	})
+}
+
+// loadActiveProject looks up the project by name or ID and lists its resources
+func loadActiveProject(client *godo.Client, ref string) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		ctx := context.Background()
+		project, err := findProject(ctx, client, ref)
+		if err != nil {
+			return errMsg(err)
+		}
+		resources, err := fetchProjectResources(ctx, client, project.ID, nil)
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list resources of project %s: %w", project.Name, err))
+		}
+		return activeProjectMsg{project: project, urns: projectURNs(resources)}
+	})
+}
+
+func moveToProject(client *godo.Client, project godo.Project, urn, name string) tea.Cmd {
+	return func() tea.Msg {
+		if _, _, err := client.Projects.AssignResources(context.Background(), project.ID, urn); err != nil {
+			return errMsg(fmt.Errorf("failed to move %s to project %s: %w", name, project.Name, err))
+		}
+		return projectResourceMovedMsg{urn: urn, project: project, message: fmt.Sprintf("✅ Moved %s to project %s", name, project.Name)}
+	}
+}
+
+// projectURNs returns the set of the resources' URNs
+func projectURNs(resources []godo.ProjectResource) map[string]bool {
+	urns := make(map[string]bool, len(resources))
+	for _, r := range resources {
+		urns[r.URN] = true
+	}
+	return urns
+}
+
+// inActiveProject reports whether the resource with the URN is in the :project
+// filter. Everything is when no project is selected.
+func (m *model) inActiveProject(urn string) bool {
+	return m.activeProject == nil || m.projectURNs[urn]
+}
+
+// filterByProject returns the items in the :project filter. The cached lists
+// keep every project's resources, for droplet details and the DNS shortcut,
+// so resource views list, count and select through the project* accessors.
+func filterByProject[T any](m *model, items []T, urn func(T) string) []T {
+	if m.activeProject == nil {
+		return items
+	}
+	filtered := []T{}
+	for _, item := range items {
+		if m.projectURNs[urn(item)] {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+func (m *model) projectVolumes() []godo.Volume {
+	return filterByProject(m, m.volumes, godo.Volume.URN)
+}
+
+func (m *model) projectLoadBalancers() []godo.LoadBalancer {
+	return filterByProject(m, m.loadBalancers, godo.LoadBalancer.URN)
+}
+
+func (m *model) projectDatabases() []godo.Database {
+	return filterByProject(m, m.databases, godo.Database.URN)
+}
+
+func (m *model) projectDomains() []godo.Domain {
+	return filterByProject(m, m.domains, godo.Domain.URN)
+}
+
+func (m *model) projectApps() []*godo.App {
+	return filterByProject(m, m.apps, (*godo.App).URN)
+}
+
+func (m *model) projectSpacesBuckets() []spacesBucket {
+	return filterByProject(m, m.spacesBuckets, func(b spacesBucket) string { return "do:space:" + b.name })
+}
+
+// setActiveProject sets or clears (nil) the :project filter and reloads the current view
+func (m *model) setActiveProject(project *godo.Project, urns map[string]bool) tea.Cmd {
+	m.activeProject = project
+	m.projectURNs = urns
+	m.table.SetCursor(0)
+	m.updateTableRows()
+	if rv := m.resourceView(); rv != nil {
+		m.loading = true
+		return tea.Batch(rv.load(m), m.spinner.Tick)
+	}
+	return nil
+}
+
+// runProjectCommand handles ":project" (show the filter), ":project all" (clear it)
+// and ":project <name>" (filter every view to the project)
+func (m *model) runProjectCommand(args []string) tea.Cmd {
+	if len(args) == 0 {
+		current := "all"
+		if m.activeProject != nil {
+			current = m.activeProject.Name
+		}
+		if len(m.projects) == 0 {
+			m.successMsg = fmt.Sprintf("Project: %s (:projects lists them)", current)
+			return nil
+		}
+		names := make([]string, len(m.projects))
+		for i, p := range m.projects {
+			names[i] = p.Name
+			if m.activeProject != nil && p.ID == m.activeProject.ID {
+				names[i] = "*" + p.Name
+			}
+		}
+		m.successMsg = fmt.Sprintf("Project: %s (available: %s)", current, strings.Join(names, ", "))
+		return nil
+	}
+	ref := strings.Join(args, " ")
+	if strings.EqualFold(ref, "all") {
+		m.successMsg = "Showing resources of all projects"
+		return m.setActiveProject(nil, nil)
+	}
+	m.err = nil
+	m.loading = true
+	return tea.Batch(loadActiveProject(m.client, ref), m.spinner.Tick)
+}
+
+// createProject returns the project the create form puts new droplets in: the
+// :project filter, then the context's default project ("" = the account's default)
+func (m *model) createProject() string {
+	if m.activeProject != nil {
+		return m.activeProject.ID
+	}
+	if m.activeContext != nil {
+		return m.activeContext.DefaultProject
+	}
+	return ""
+}
+
+// createProjectLabel describes createProject for the create form
+func (m *model) createProjectLabel() string {
+	if m.activeProject != nil {
+		return m.activeProject.Name
+	}
+	if ref := m.createProject(); ref != "" {
+		return ref
+	}
+	return "Account default"
+}
+
+// resourceProject returns the project the resource is in, if the projects are loaded
+func (m *model) resourceProject(urn string) *godo.Project {
+	for i, p := range m.projects {
+		for _, r := range m.projectResources[p.ID] {
+			if r.URN == urn {
+				return &m.projects[i]
+			}
+		}
+	}
+	return nil
+}
+
+// startProjectMove opens the project picker for a resource, loading the projects first if needed
+func (m *model) startProjectMove(urn, name string) tea.Cmd {
+	if m.projects == nil {
+		m.pendingProjectMove = &projectMove{urn: urn, name: name}
+		m.loading = true
+		return tea.Batch(loadProjects(m.client, m.pageProgressChan), m.spinner.Tick)
+	}
+	m.openProjectMovePicker(urn, name)
+	return nil
+}
+
+func (m *model) openProjectMovePicker(urn, name string) {
+	current := m.resourceProject(urn)
+	var options []pickerOption
+	for _, p := range m.projects {
+		label := p.Name
+		if p.IsDefault {
+			label += " (default)"
+		}
+		if current != nil && current.ID == p.ID {
+			label += " - current"
+		}
+		options = append(options, pickerOption{label: label, value: p.ID})
+	}
+	m.picker = &pickerDialog{
+		title:   "📁 Move " + name + " to project",
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			var project *godo.Project
+			for i := range m.projects {
+				if m.projects[i].ID == option.value {
+					project = &m.projects[i]
+					break
+				}
+			}
+			if project == nil {
+				return nil
+			}
+			if current != nil && current.ID == project.ID {
+				m.successMsg = fmt.Sprintf("%s is already in project %s", name, project.Name)
+				return nil
+			}
+			from := "unknown"
+			if current != nil {
+				from = current.Name
+			}
+			target := *project
+			m.confirm = &confirmDialog{
+				title: "Move to Project?",
+				lines: []string{
+					fmt.Sprintf("Resource: %s", name),
+					fmt.Sprintf("From: %s", from),
+					fmt.Sprintf("To: %s", target.Name),
+				},
+				onConfirm: func(m *model) tea.Cmd {
+					m.loading = true
+					return tea.Batch(moveToProject(m.client, target, urn, name), m.spinner.Tick)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+// projectResourceName resolves a resource URN (e.g. "do:droplet:123") to the
+// resource's type and name, using whatever lists are loaded
+func (m *model) projectResourceName(urn string) (string, string) {
+	parts := strings.SplitN(urn, ":", 3)
+	if len(parts) != 3 {
+		return "other", urn
+	}
+	kind, id := parts[1], parts[2]
+	switch kind {
+	case "droplet":
+		if n, err := strconv.Atoi(id); err == nil {
+			return kind, m.dropletName(n)
+		}
+	case "kubernetes":
+		for _, c := range m.clusters {
+			if c.ID == id {
+				return kind, c.Name
+			}
+		}
+	case "volume":
+		for _, v := range m.volumes {
+			if v.ID == id {
+				return kind, v.Name
+			}
+		}
+	case "loadbalancer":
+		for _, lb := range m.loadBalancers {
+			if lb.ID == id {
+				return kind, lb.Name
+			}
+		}
+	case "dbaas":
+		for _, db := range m.databases {
+			if db.ID == id {
+				return kind, db.Name
+			}
+		}
+	case "app":
+		for _, app := range m.apps {
+			if app.ID == id {
+				return kind, appName(app)
+			}
+		}
+	}
+	return kind, id
+}
+
+func (m *model) selectedProject() (godo.Project, bool) {
+	i := m.selectedIndex(len(m.projects))
+	if i < 0 {
+		return godo.Project{}, false
+	}
+	return m.projects[i], true
+}
+
+func (m *model) updateProjectTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.22, 12},
+		{"PURPOSE", 0.22, 10},
+		{"ENVIRONMENT", 0.12, 8},
+		{"RESOURCES", 0.10, 6},
+		{"DEFAULT", 0.08, 6},
+		{"FILTER", 0.08, 6},
+		{"UPDATED", 0.18, 10},
+	})
+	var rows []table.Row
+	for _, p := range m.projects {
+		isDefault, active := "", ""
+		if p.IsDefault {
+			isDefault = "●"
+		}
+		if m.activeProject != nil && m.activeProject.ID == p.ID {
+			active = "●"
+		}
+		environment := p.Environment
+		if environment == "" {
+			environment = "-"
+		}
+		rows = append(rows, table.Row{
+			p.Name,
+			p.Purpose,
+			environment,
+			strconv.Itoa(len(m.projectResources[p.ID])),
+			isDefault,
+			active,
+			formatSnapshotTime(p.UpdatedAt),
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleProjectKey(key string) (tea.Cmd, bool) {
+	if key != "f" && key != "F" {
+		return nil, false
+	}
+	p, ok := m.selectedProject()
+	if !ok {
+		return nil, true
+	}
+	if m.activeProject != nil && m.activeProject.ID == p.ID {
+		m.successMsg = "Showing resources of all projects"
+		return m.setActiveProject(nil, nil), true
+	}
+	m.successMsg = fmt.Sprintf("Showing resources of project %s", p.Name)
+	return m.setActiveProject(&p, projectURNs(m.projectResources[p.ID])), true
+}
+
+func (m model) renderProjectDetails() string {
+	p, ok := m.selectedProject()
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("📁 Project: %s", p.Name)))
+	b.WriteString("\n\n")
+	isDefault := "No"
+	if p.IsDefault {
+		isDefault = "Yes (new resources land here unless moved)"
+	}
+	b.WriteString(renderDetailsSection("Project", [][2]string{
+		{"ID", p.ID},
+		{"Description", p.Description},
+		{"Purpose", p.Purpose},
+		{"Environment", p.Environment},
+		{"Default", isDefault},
+		{"Created", formatSnapshotTime(p.CreatedAt)},
+		{"Updated", formatSnapshotTime(p.UpdatedAt)},
+	}))
+
+	// Resources grouped by type, sorted by name
+	byKind := map[string][][2]string{}
+	for _, r := range m.projectResources[p.ID] {
+		kind, name := m.projectResourceName(r.URN)
+		byKind[kind] = append(byKind[kind], [2]string{name, r.AssignedAt})
+	}
+	if len(byKind) == 0 {
+		b.WriteString(helpStyle.Render("No resources in this project"))
+		b.WriteString("\n")
+		return b.String()
+	}
+	kinds := make([]string, 0, len(byKind))
+	for kind := range byKind {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		pairs := byKind[kind]
+		sort.Slice(pairs, func(i, j int) bool { return pairs[i][0] < pairs[j][0] })
+		for i := range pairs {
+			pairs[i][1] = "assigned " + formatSnapshotTime(pairs[i][1])
+		}
+		b.WriteString(renderDetailsSection(fmt.Sprintf("%s (%d)", projectResourceKindLabel(kind), len(pairs)), pairs))
+	}
+	return b.String()
+}
+
+// projectResourceKindLabel returns a display label for a URN resource type, e.g. "dbaas" -> "Databases"
+func projectResourceKindLabel(kind string) string {
+	switch kind {
+	case "droplet":
+		return "Droplets"
+	case "kubernetes":
+		return "Kubernetes Clusters"
+	case "volume":
+		return "Volumes"
+	case "loadbalancer":
+		return "Load Balancers"
+	case "domain":
+		return "Domains"
+	case "dbaas":
+		return "Databases"
+	case "space":
+		return "Spaces"
+	case "app":
+		return "Apps"
+	case "floatingip", "reservedip":
+		return "Reserved IPs"
+	}
+	return imageTypeLabel(kind)
+}
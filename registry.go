@@ -0,0 +1,629 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// errNoRegistry is returned when the account has no container registry
+var errNoRegistry = errors.New("registry: this account has no container registry, create one under Container Registry in the control panel")
+
+// registryLoadedMsg carries the registry, its repositories and its garbage collections
+type registryLoadedMsg struct {
+	registry *godo.Registry
+	repos    []*godo.RepositoryV2
+	gcs      []*godo.GarbageCollection
+}
+
+// registryRepoLoadedMsg carries a repository's tags and manifests
+type registryRepoLoadedMsg struct {
+	repo      string
+	tags      []*godo.RepositoryTag
+	manifests []*godo.RepositoryManifest
+}
+
+// registryChangedMsg reports a deleted tag or manifest, a started or cancelled
+// garbage collection, or a cluster integration change
+type registryChangedMsg string
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewRegistry,
+		aliases: []string{"registries", "docr", "cr"},
+		title:   "Container Registry",
+		load: func(m *model) tea.Cmd {
+			if m.registry != nil && m.registryRepo != "" {
+				return tea.Batch(loadRegistry(m.client, m.pageProgressChan), loadRegistryRepo(m.client, m.registry.Name, m.registryRepo, m.pageProgressChan))
+			}
+			return loadRegistry(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			switch m.registryResourceType {
+			case "tags":
+				return len(m.registryTags)
+			case "manifests":
+				return len(m.registryManifests)
+			case "garbage-collections":
+				return len(m.registryGCs)
+			}
+			return len(m.registryRepos)
+		},
+		updateTable: (*model).updateRegistryTable,
+		handleKey:   (*model).handleRegistryKey,
+		keys: []keyHint{
+			{"t", "Switch list"},
+			{"x", "Delete/Cancel"},
+			{"g", "Garbage collect"},
+			{"a", "Attach to cluster"},
+		},
+		renderDetails: model.renderRegistryDetails,
+	})
+}
+
+func loadRegistry(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		ctx := context.Background()
+		registry, resp, err := client.Registry.Get(ctx)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return errMsg(errNoRegistry)
+			}
+			return errMsg(fmt.Errorf("failed to get registry: %w", err))
+		}
+		repos, err := fetchRegistryRepos(ctx, client, registry.Name, reportProgress(progressChan, client, "repositories"))
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list repositories: %w", err))
+		}
+		gcs, err := fetchGarbageCollections(ctx, client, registry.Name, nil)
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list garbage collections: %w", err))
+		}
+		return registryLoadedMsg{registry: registry, repos: repos, gcs: gcs}
+	})
+}
+
+func loadRegistryRepo(client *godo.Client, registry, repo string, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		ctx := context.Background()
+		tags, err := fetchRegistryTags(ctx, client, registry, repo, reportProgress(progressChan, client, "tags"))
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list tags of %s: %w", repo, err))
+		}
+		manifests, err := fetchRegistryManifests(ctx, client, registry, repo, reportProgress(progressChan, client, "manifests"))
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list manifests of %s: %w", repo, err))
+		}
+		return registryRepoLoadedMsg{repo: repo, tags: tags, manifests: manifests}
+	})
+}
+
+func deleteRegistryTag(client *godo.Client, registry, repo, tag string) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := client.Registry.DeleteTag(context.Background(), registry, repo, tag); err != nil {
+			return errMsg(fmt.Errorf("failed to delete tag %s:%s: %w", repo, tag, err))
+		}
+		return registryChangedMsg(fmt.Sprintf("✅ Deleted tag %s:%s, run garbage collection to free its storage", repo, tag))
+	}
+}
+
+func deleteRegistryManifest(client *godo.Client, registry, repo, digest string) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := client.Registry.DeleteManifest(context.Background(), registry, repo, digest); err != nil {
+			return errMsg(fmt.Errorf("failed to delete manifest %s@%s: %w", repo, shortDigest(digest), err))
+		}
+		return registryChangedMsg(fmt.Sprintf("✅ Deleted manifest %s@%s, run garbage collection to free its storage", repo, shortDigest(digest)))
+	}
+}
+
+func startGarbageCollection(client *godo.Client, registry string, gcType godo.GarbageCollectionType) tea.Cmd {
+	return func() tea.Msg {
+		gc, _, err := client.Registry.StartGarbageCollection(context.Background(), registry, &godo.StartGarbageCollectionRequest{Type: gcType})
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to start garbage collection: %w", err))
+		}
+		return registryChangedMsg(fmt.Sprintf("✅ Started garbage collection %s (%s)", shortID(gc.UUID), gcType))
+	}
+}
+
+func cancelGarbageCollection(client *godo.Client, registry, uuid string) tea.Cmd {
+	return func() tea.Msg {
+		if _, _, err := client.Registry.UpdateGarbageCollection(context.Background(), registry, uuid, &godo.UpdateGarbageCollectionRequest{Cancel: true}); err != nil {
+			return errMsg(fmt.Errorf("failed to cancel garbage collection: %w", err))
+		}
+		return registryChangedMsg(fmt.Sprintf("✅ Cancelling garbage collection %s", shortID(uuid)))
+	}
+}
+
+// setClusterRegistry adds or removes the registry integration of a cluster, which
+// installs (or removes) the registry's pull secret in every namespace
+func setClusterRegistry(client *godo.Client, cluster *godo.KubernetesCluster, attach bool) tea.Cmd {
+	return func() tea.Msg {
+		req := &godo.KubernetesClusterRegistryRequest{ClusterUUIDs: []string{cluster.ID}}
+		if attach {
+			if _, err := client.Kubernetes.AddRegistry(context.Background(), req); err != nil {
+				return errMsg(fmt.Errorf("failed to attach registry to %s: %w", cluster.Name, err))
+			}
+			return registryChangedMsg(fmt.Sprintf("✅ Attached registry to cluster %s", cluster.Name))
+		}
+		if _, err := client.Kubernetes.RemoveRegistry(context.Background(), req); err != nil {
+			return errMsg(fmt.Errorf("failed to detach registry from %s: %w", cluster.Name, err))
+		}
+		return registryChangedMsg(fmt.Sprintf("✅ Detached registry from cluster %s", cluster.Name))
+	}
+}
+
+// shortDigest returns the first 12 hex characters of a digest, like docker does
+func shortDigest(digest string) string {
+	hex := strings.TrimPrefix(digest, "sha256:")
+	if len(hex) > 12 {
+		return hex[:12]
+	}
+	return hex
+}
+
+// gcRunning reports whether a garbage collection hasn't finished yet. The
+// registry is read-only while one runs.
+func gcRunning(gc *godo.GarbageCollection) bool {
+	switch gc.Status {
+	case "succeeded", "failed", "cancelled":
+		return false
+	}
+	return true
+}
+
+// runningGarbageCollection returns the unfinished garbage collection, if any
+func (m *model) runningGarbageCollection() *godo.GarbageCollection {
+	for _, gc := range m.registryGCs {
+		if gcRunning(gc) {
+			return gc
+		}
+	}
+	return nil
+}
+
+// registryImageRef returns the pullable reference of a repository, e.g. "registry.digitalocean.com/acme/web"
+func (m *model) registryImageRef(repo string) string {
+	return fmt.Sprintf("registry.digitalocean.com/%s/%s", m.registry.Name, repo)
+}
+
+func (m *model) selectedRegistryRepo() *godo.RepositoryV2 {
+	if m.registryRepo != "" || m.registryResourceType == "garbage-collections" {
+		return nil
+	}
+	i := m.selectedIndex(len(m.registryRepos))
+	if i < 0 {
+		return nil
+	}
+	return m.registryRepos[i]
+}
+
+func (m *model) selectedRegistryTag() *godo.RepositoryTag {
+	if m.registryResourceType != "tags" {
+		return nil
+	}
+	i := m.selectedIndex(len(m.registryTags))
+	if i < 0 {
+		return nil
+	}
+	return m.registryTags[i]
+}
+
+func (m *model) selectedRegistryManifest() *godo.RepositoryManifest {
+	if m.registryResourceType != "manifests" {
+		return nil
+	}
+	i := m.selectedIndex(len(m.registryManifests))
+	if i < 0 {
+		return nil
+	}
+	return m.registryManifests[i]
+}
+
+func (m *model) selectedGarbageCollection() *godo.GarbageCollection {
+	if m.registryResourceType != "garbage-collections" {
+		return nil
+	}
+	i := m.selectedIndex(len(m.registryGCs))
+	if i < 0 {
+		return nil
+	}
+	return m.registryGCs[i]
+}
+
+// showRegistryList switches the list shown, resetting the cursor
+func (m *model) showRegistryList(resourceType string) {
+	m.registryResourceType = resourceType
+	m.table.SetCursor(0)
+	m.updateTableRows()
+}
+
+func (m *model) updateRegistryTable() {
+	switch m.registryResourceType {
+	case "tags":
+		columns := responsiveColumns(m.width, []columnSpec{
+			{m.registryRepo + " TAG", 0.30, 12},
+			{"DIGEST", 0.18, 12},
+			{"SIZE", 0.12, 9},
+			{"COMPRESSED", 0.14, 10},
+			{"UPDATED", 0.26, 16},
+		})
+		var rows []table.Row
+		for _, t := range m.registryTags {
+			rows = append(rows, table.Row{
+				t.Tag,
+				shortDigest(t.ManifestDigest),
+				formatByteSize(int64(t.SizeBytes)),
+				formatByteSize(int64(t.CompressedSizeBytes)),
+				t.UpdatedAt.Local().Format("2006-01-02 15:04"),
+			})
+		}
+		m.setTableData(columns, rows)
+
+	case "manifests":
+		columns := responsiveColumns(m.width, []columnSpec{
+			{m.registryRepo + " DIGEST", 0.22, 14},
+			{"TAGS", 0.28, 10},
+			{"SIZE", 0.12, 9},
+			{"COMPRESSED", 0.14, 10},
+			{"UPDATED", 0.24, 16},
+		})
+		var rows []table.Row
+		for _, mf := range m.registryManifests {
+			tags := strings.Join(mf.Tags, ", ")
+			if tags == "" {
+				tags = "<untagged>"
+			}
+			rows = append(rows, table.Row{
+				shortDigest(mf.Digest),
+				tags,
+				formatByteSize(int64(mf.SizeBytes)),
+				formatByteSize(int64(mf.CompressedSizeBytes)),
+				mf.UpdatedAt.Local().Format("2006-01-02 15:04"),
+			})
+		}
+		m.setTableData(columns, rows)
+
+	case "garbage-collections":
+		columns := responsiveColumns(m.width, []columnSpec{
+			{"ID", 0.12, 8},
+			{"STATUS", 0.30, 12},
+			{"BLOBS DELETED", 0.14, 8},
+			{"FREED", 0.12, 8},
+			{"STARTED", 0.16, 16},
+			{"UPDATED", 0.16, 16},
+		})
+		var rows []table.Row
+		for _, gc := range m.registryGCs {
+			rows = append(rows, table.Row{
+				shortID(gc.UUID),
+				gc.Status,
+				fmt.Sprintf("%d", gc.BlobsDeleted),
+				formatByteSize(int64(gc.FreedBytes)),
+				gc.CreatedAt.Local().Format("2006-01-02 15:04"),
+				gc.UpdatedAt.Local().Format("2006-01-02 15:04"),
+			})
+		}
+		m.setTableData(columns, rows)
+
+	default:
+		name := "REPOSITORY"
+		if m.registry != nil {
+			name = m.registry.Name + " REPOSITORY"
+		}
+		columns := responsiveColumns(m.width, []columnSpec{
+			{name, 0.34, 16},
+			{"TAGS", 0.08, 5},
+			{"MANIFESTS", 0.10, 6},
+			{"LATEST", 0.22, 12},
+			{"UPDATED", 0.26, 16},
+		})
+		var rows []table.Row
+		for _, r := range m.registryRepos {
+			latest, updated := "-", "-"
+			if r.LatestManifest != nil {
+				latest = strings.Join(r.LatestManifest.Tags, ", ")
+				if latest == "" {
+					latest = shortDigest(r.LatestManifest.Digest)
+				}
+				updated = r.LatestManifest.UpdatedAt.Local().Format("2006-01-02 15:04")
+			}
+			rows = append(rows, table.Row{r.Name, fmt.Sprintf("%d", r.TagCount), fmt.Sprintf("%d", r.ManifestCount), latest, updated})
+		}
+		m.setTableData(columns, rows)
+	}
+}
+
+func (m *model) handleRegistryKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "enter":
+		repo := m.selectedRegistryRepo()
+		if repo == nil {
+			return nil, false // Tag, manifest or garbage collection details
+		}
+		m.registryRepo = repo.Name
+		m.registryTags = nil
+		m.registryManifests = nil
+		m.loading = true
+		m.showRegistryList("tags")
+		return tea.Batch(loadRegistryRepo(m.client, m.registry.Name, repo.Name, m.pageProgressChan), m.spinner.Tick), true
+
+	case "esc":
+		switch m.registryResourceType {
+		case "tags", "manifests":
+			// Back to the repository list, with the cursor on the repository
+			repo := m.registryRepo
+			m.registryRepo = ""
+			m.registryTags = nil
+			m.registryManifests = nil
+			m.showRegistryList("repositories")
+			for i, r := range m.registryRepos {
+				if r.Name == repo {
+					m.table.SetCursor(i)
+					break
+				}
+			}
+			return nil, true
+		case "garbage-collections":
+			m.showRegistryList("repositories")
+			return nil, true
+		}
+		return nil, false
+
+	case "t", "T":
+		switch m.registryResourceType {
+		case "tags":
+			m.showRegistryList("manifests")
+		case "manifests":
+			m.showRegistryList("tags")
+		case "garbage-collections":
+			m.showRegistryList("repositories")
+		default:
+			m.showRegistryList("garbage-collections")
+		}
+		return nil, true
+
+	case "x", "X":
+		return m.confirmRegistryDelete(), true
+
+	case "g", "G":
+		if m.registry == nil {
+			return nil, true
+		}
+		if gc := m.runningGarbageCollection(); gc != nil {
+			m.err = fmt.Errorf("garbage collection: %s is already running (%s)", shortID(gc.UUID), gc.Status)
+			return nil, true
+		}
+		registry := m.registry.Name
+		m.picker = &pickerDialog{
+			title: "🧹 Garbage collect " + registry,
+			options: []pickerOption{
+				{label: "Untagged manifests and unreferenced blobs", value: string(godo.GCTypeUntaggedManifestsAndUnreferencedBlobs)},
+				{label: "Unreferenced blobs only", value: string(godo.GCTypeUnreferencedBlobsOnly)},
+				{label: "Untagged manifests only", value: string(godo.GCTypeUntaggedManifestsOnly)},
+			},
+			onPick: func(m *model, option pickerOption) tea.Cmd {
+				m.confirm = &confirmDialog{
+					title:   "Start Garbage Collection?",
+					lines:   []string{fmt.Sprintf("Registry: %s", registry), fmt.Sprintf("Deletes: %s", strings.ToLower(option.label))},
+					warning: "The registry is read-only until garbage collection finishes, so pushes will fail.",
+					onConfirm: func(m *model) tea.Cmd {
+						m.loading = true
+						return tea.Batch(startGarbageCollection(m.client, registry, godo.GarbageCollectionType(option.value)), m.spinner.Tick)
+					},
+				}
+				return nil
+			},
+		}
+		return nil, true
+
+	case "a", "A":
+		m.openClusterRegistryPicker()
+		return nil, true
+	}
+	return nil, false
+}
+
+// confirmRegistryDelete asks to delete the selected tag or manifest, or to cancel the selected garbage collection
+func (m *model) confirmRegistryDelete() tea.Cmd {
+	if m.registry == nil {
+		return nil
+	}
+	registry, repo := m.registry.Name, m.registryRepo
+	switch {
+	case m.selectedRegistryTag() != nil:
+		tag := m.selectedRegistryTag()
+		m.confirm = &confirmDialog{
+			title: "Delete Tag?",
+			lines: []string{
+				fmt.Sprintf("Tag: %s:%s", m.registryImageRef(repo), tag.Tag),
+				fmt.Sprintf("Manifest: %s", tag.ManifestDigest),
+			},
+			warning: "The manifest stays until garbage collection removes it, unless other tags point at it.",
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(deleteRegistryTag(m.client, registry, repo, tag.Tag), m.spinner.Tick)
+			},
+		}
+	case m.selectedRegistryManifest() != nil:
+		mf := m.selectedRegistryManifest()
+		tags := strings.Join(mf.Tags, ", ")
+		if tags == "" {
+			tags = "<untagged>"
+		}
+		m.confirm = &confirmDialog{
+			title: "Delete Manifest?",
+			lines: []string{
+				fmt.Sprintf("Manifest: %s@%s", m.registryImageRef(repo), mf.Digest),
+				fmt.Sprintf("Tags: %s", tags),
+			},
+			warning: "All tags pointing at this manifest are deleted too. This action cannot be undone!",
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(deleteRegistryManifest(m.client, registry, repo, mf.Digest), m.spinner.Tick)
+			},
+		}
+	case m.selectedGarbageCollection() != nil:
+		gc := m.selectedGarbageCollection()
+		if !gcRunning(gc) {
+			m.err = fmt.Errorf("garbage collection: %s has already %s", shortID(gc.UUID), gc.Status)
+			return nil
+		}
+		m.confirm = &confirmDialog{
+			title: "Cancel Garbage Collection?",
+			lines: []string{fmt.Sprintf("Garbage collection: %s", gc.UUID), fmt.Sprintf("Status: %s", gc.Status)},
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(cancelGarbageCollection(m.client, registry, gc.UUID), m.spinner.Tick)
+			},
+		}
+	default:
+		m.err = fmt.Errorf("delete: open a repository and select a tag or manifest first")
+	}
+	return nil
+}
+
+// openClusterRegistryPicker lets the user pick a cluster to attach the registry
+// to, or to detach it from if it's already attached
+func (m *model) openClusterRegistryPicker() {
+	if m.registry == nil {
+		return
+	}
+	if len(m.clusters) == 0 {
+		m.err = fmt.Errorf("attach: no Kubernetes clusters in this context")
+		return
+	}
+	var options []pickerOption
+	for _, c := range m.clusters {
+		label := fmt.Sprintf("%s (%s)", c.Name, c.RegionSlug)
+		if c.RegistryEnabled {
+			label += " - attached"
+		}
+		options = append(options, pickerOption{label: label, value: c.ID})
+	}
+	registry := m.registry.Name
+	m.picker = &pickerDialog{
+		title:   "☸️  Attach " + registry + " to cluster",
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			var cluster *godo.KubernetesCluster
+			for _, c := range m.clusters {
+				if c.ID == option.value {
+					cluster = c
+					break
+				}
+			}
+			if cluster == nil {
+				return nil
+			}
+			if cluster.RegistryEnabled {
+				m.confirm = &confirmDialog{
+					title:   "Detach Registry?",
+					lines:   []string{fmt.Sprintf("Registry: %s", registry), fmt.Sprintf("Cluster: %s", cluster.Name)},
+					warning: "The cluster's registry pull secrets are removed, so new pulls of private images will fail.",
+					onConfirm: func(m *model) tea.Cmd {
+						m.loading = true
+						return tea.Batch(setClusterRegistry(m.client, cluster, false), m.spinner.Tick)
+					},
+				}
+				return nil
+			}
+			m.confirm = &confirmDialog{
+				title: "Attach Registry?",
+				lines: []string{
+					fmt.Sprintf("Registry: %s", registry),
+					fmt.Sprintf("Cluster: %s", cluster.Name),
+					"Adds a pull secret for the registry to every namespace.",
+				},
+				onConfirm: func(m *model) tea.Cmd {
+					m.loading = true
+					return tea.Batch(setClusterRegistry(m.client, cluster, true), m.spinner.Tick)
+				},
+			}
+			return nil
+		},
+	}
+}
+
+func (m model) renderRegistryDetails() string {
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	switch {
+	case m.selectedRegistryTag() != nil:
+		t := m.selectedRegistryTag()
+		b.WriteString(headerStyle.Render(fmt.Sprintf("🏷️  %s:%s", t.Repository, t.Tag)))
+		b.WriteString("\n\n")
+		b.WriteString(renderDetailsSection("Tag", [][2]string{
+			{"Image", fmt.Sprintf("%s:%s", m.registryImageRef(t.Repository), t.Tag)},
+			{"Manifest", t.ManifestDigest},
+			{"Size", fmt.Sprintf("%s (%s compressed)", formatByteSize(int64(t.SizeBytes)), formatByteSize(int64(t.CompressedSizeBytes)))},
+			{"Updated", t.UpdatedAt.Local().Format("2006-01-02 15:04:05")},
+		}))
+
+	case m.selectedRegistryManifest() != nil:
+		mf := m.selectedRegistryManifest()
+		tags := strings.Join(mf.Tags, ", ")
+		if tags == "" {
+			tags = "<untagged>"
+		}
+		b.WriteString(headerStyle.Render(fmt.Sprintf("📦 %s@%s", mf.Repository, shortDigest(mf.Digest))))
+		b.WriteString("\n\n")
+		b.WriteString(renderDetailsSection("Manifest", [][2]string{
+			{"Image", fmt.Sprintf("%s@%s", m.registryImageRef(mf.Repository), mf.Digest)},
+			{"Tags", tags},
+			{"Size", fmt.Sprintf("%s (%s compressed)", formatByteSize(int64(mf.SizeBytes)), formatByteSize(int64(mf.CompressedSizeBytes)))},
+			{"Updated", mf.UpdatedAt.Local().Format("2006-01-02 15:04:05")},
+		}))
+		var blobs [][2]string
+		for _, blob := range mf.Blobs {
+			blobs = append(blobs, [2]string{shortDigest(blob.Digest), formatByteSize(int64(blob.CompressedSizeBytes))})
+		}
+		if len(blobs) > 0 {
+			b.WriteString(renderDetailsSection(fmt.Sprintf("Blobs (%d)", len(blobs)), blobs))
+		}
+
+	case m.selectedGarbageCollection() != nil:
+		gc := m.selectedGarbageCollection()
+		b.WriteString(headerStyle.Render(fmt.Sprintf("🧹 Garbage collection %s", shortID(gc.UUID))))
+		b.WriteString("\n\n")
+		b.WriteString(renderDetailsSection("Garbage Collection", [][2]string{
+			{"ID", gc.UUID},
+			{"Registry", gc.RegistryName},
+			{"Status", gc.Status},
+			{"Blobs deleted", fmt.Sprintf("%d", gc.BlobsDeleted)},
+			{"Freed", formatByteSize(int64(gc.FreedBytes))},
+			{"Started", gc.CreatedAt.Local().Format("2006-01-02 15:04:05")},
+			{"Updated", gc.UpdatedAt.Local().Format("2006-01-02 15:04:05")},
+		}))
+
+	case m.selectedRegistryRepo() != nil:
+		r := m.selectedRegistryRepo()
+		b.WriteString(headerStyle.Render(fmt.Sprintf("🐳 %s", r.Name)))
+		b.WriteString("\n\n")
+		pairs := [][2]string{
+			{"Image", m.registryImageRef(r.Name)},
+			{"Tags", fmt.Sprintf("%d", r.TagCount)},
+			{"Manifests", fmt.Sprintf("%d", r.ManifestCount)},
+		}
+		if r.LatestManifest != nil {
+			pairs = append(pairs,
+				[2]string{"Latest manifest", r.LatestManifest.Digest},
+				[2]string{"Latest tags", strings.Join(r.LatestManifest.Tags, ", ")},
+				[2]string{"Updated", r.LatestManifest.UpdatedAt.Local().Format("2006-01-02 15:04:05")},
+			)
+		}
+		b.WriteString(renderDetailsSection("Repository", pairs))
+		b.WriteString(renderDetailsSection("Registry", [][2]string{
+			{"Name", m.registry.Name},
+			{"Region", m.registry.Region},
+			{"Storage used", formatByteSize(int64(m.registry.StorageUsageBytes))},
+		}))
+	}
+	return b.String()
+}
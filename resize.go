@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/digitalocean/godo"
+)
+
+// resizeState tracks the droplet resize flow: pick a size, compare, confirm
+type resizeState struct {
+	droplet    godo.Droplet
+	target     *godo.Size // Chosen size (nil while choosing)
+	resizeDisk bool       // Also grow the disk (irreversible)
+}
+
+// startResize opens the resize flow for the droplet, loading sizes if needed
+func (m *model) startResize(d godo.Droplet) tea.Cmd {
+	m.resizing = &resizeState{droplet: d}
+	m.err = nil
+	if len(m.availableSizes) == 0 {
+		m.loading = true
+		return tea.Batch(loadSizes(m.client, m.pageProgressChan), m.spinner.Tick)
+	}
+	m.setupSelectionTable("size")
+	return nil
+}
+
+// currentSize returns the resizing droplet's size, from the droplet or the size list
+func (r *resizeState) currentSize(sizes []godo.Size) *godo.Size {
+	if r.droplet.Size != nil {
+		return r.droplet.Size
+	}
+	for i := range sizes {
+		if sizes[i].Slug == r.droplet.SizeSlug {
+			return &sizes[i]
+		}
+	}
+	return nil
+}
+
+// selectableSizes returns the sizes offered in the size picker. While
+// resizing, only sizes available in the droplet's region that keep at least
+// the current disk are listed (disks can never shrink).
+func (m *model) selectableSizes() []godo.Size {
+	if m.resizing == nil {
+		return m.availableSizes
+	}
+
+	region := ""
+	if m.resizing.droplet.Region != nil {
+		region = m.resizing.droplet.Region.Slug
+	}
+	var sizes []godo.Size
+	for _, s := range m.availableSizes {
+		if !s.Available || s.Slug == m.resizing.droplet.SizeSlug || s.Disk < m.resizing.droplet.Disk {
+			continue
+		}
+		if region != "" && !containsString(s.Regions, region) {
+			continue
+		}
+		sizes = append(sizes, s)
+	}
+	return sizes
+}
+
+func (m model) updateResize(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	r := m.resizing
+
+	// Size selection stage - reuses the create form's size table
+	if r.target == nil {
+		switch msg.String() {
+		case "esc":
+			m.resizing = nil
+			return m, nil
+		case "enter":
+			row := m.selectionTable.SelectedRow()
+			if len(row) == 0 {
+				return m, nil
+			}
+			for _, s := range m.selectableSizes() {
+				if s.Slug == row[0] {
+					size := s
+					r.target = &size
+					break
+				}
+			}
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.selectionTable, cmd = m.selectionTable.Update(msg)
+		return m, cmd
+	}
+
+	// Comparison stage
+	switch msg.String() {
+	case "esc", "backspace":
+		// Back to size selection
+		r.target = nil
+		r.resizeDisk = false
+		return m, nil
+	case "d", "D", " ":
+		r.resizeDisk = !r.resizeDisk
+		return m, nil
+	case "enter", "y", "Y":
+		if m.runningAction != nil {
+			m.err = fmt.Errorf("another droplet action is in progress")
+			return m, nil
+		}
+		m.confirm = m.resizeConfirmDialog()
+		return m, nil
+	}
+	return m, nil
+}
+
+// resizeSteps returns the action sequence for the resize: power off if
+// running, resize, then power back on if it was running - also when the
+// resize fails
+func (r *resizeState) resizeSteps() []dropletActionStep {
+	slug := r.target.Slug
+	resizeDisk := r.resizeDisk
+	resize := dropletActionStep{label: "Resize", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+		return c.DropletActions.Resize(ctx, id, slug, resizeDisk)
+	}}
+	if r.droplet.Status != "active" {
+		return []dropletActionStep{resize}
+	}
+	return []dropletActionStep{
+		{label: "Power off", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+			return c.DropletActions.PowerOff(ctx, id)
+		}},
+		resize,
+		{label: "Power on", recovery: "droplet left powered off", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+			return c.DropletActions.PowerOn(ctx, id)
+		}},
+	}
+}
+
+func (m *model) resizeConfirmDialog() *confirmDialog {
+	r := m.resizing
+	steps := r.resizeSteps()
+	labels := make([]string, len(steps))
+	for i, step := range steps {
+		labels[i] = step.label
+	}
+
+	dialog := &confirmDialog{
+		title: "Resize Droplet?",
+		lines: []string{
+			fmt.Sprintf("Droplet: %s", r.droplet.Name),
+			fmt.Sprintf("Size: %s → %s", r.droplet.SizeSlug, r.target.Slug),
+			fmt.Sprintf("Steps: %s", strings.Join(labels, " → ")),
+		},
+		onConfirm: func(m *model) tea.Cmd {
+			r := m.resizing
+			m.resizing = nil
+			return m.startTrackedAction(r.droplet, "Resize to "+r.target.Slug, r.resizeSteps())
+		},
+	}
+	if r.resizeDisk {
+		dialog.warning = "The disk will be resized too. This is permanent - the droplet can never be downsized afterwards."
+	} else if r.droplet.Status == "active" {
+		dialog.warning = "The droplet will be powered off during the resize."
+	}
+	return dialog
+}
+
+func (m model) renderResize() string {
+	r := m.resizing
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+
+	region := ""
+	if r.droplet.Region != nil {
+		region = r.droplet.Region.Slug
+	}
+
+	if r.target == nil {
+		s.WriteString(headerStyle.Render(fmt.Sprintf("📐 Resize %s - Select Size", r.droplet.Name)))
+		s.WriteString("\n\n")
+		s.WriteString(fmt.Sprintf("Current size: %s | Region: %s (showing sizes available in this region)\n\n", r.droplet.SizeSlug, region))
+		if m.loading && len(m.availableSizes) == 0 {
+			s.WriteString(fmt.Sprintf("%s Loading sizes...\n\n", m.spinner.View()))
+		} else {
+			s.WriteString(m.selectionTable.View())
+			s.WriteString("\n\n")
+		}
+		s.WriteString(helpStyle.Render("[↑/↓] Navigate  [enter] Compare  [esc] Cancel"))
+		s.WriteString("\n")
+		return s.String()
+	}
+
+	s.WriteString(headerStyle.Render(fmt.Sprintf("📐 Resize %s", r.droplet.Name)))
+	s.WriteString("\n\n")
+
+	current := r.currentSize(m.availableSizes)
+	columnStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 2).
+		Width(30)
+	currentPanel := columnStyle.Render(renderSizeSpec("Current", r.droplet.SizeSlug, current))
+	targetPanel := columnStyle.BorderForeground(highlightColor).Render(renderSizeSpec("Target", r.target.Slug, r.target))
+	s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, currentPanel, "  →  ", targetPanel))
+	s.WriteString("\n\n")
+
+	checkbox := "[ ]"
+	if r.resizeDisk {
+		checkbox = "[x]"
+	}
+	s.WriteString(fmt.Sprintf("%s Resize disk (irreversible)", checkbox))
+	if current != nil && r.target.Disk > current.Disk {
+		s.WriteString(fmt.Sprintf(" - %dGB → %dGB", current.Disk, r.target.Disk))
+	}
+	s.WriteString("\n")
+	if !r.resizeDisk {
+		s.WriteString(helpStyle.Render("    CPU/RAM only: the disk keeps its size, so you can still downsize later"))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(helpStyle.Render("[d] Toggle disk resize  [enter] Resize  [esc] Back to sizes"))
+	s.WriteString("\n")
+	return s.String()
+}
+
+// renderSizeSpec renders a size's vCPU, RAM, disk and price for the comparison panels
+func renderSizeSpec(title, slug string, size *godo.Size) string {
+	var s strings.Builder
+	s.WriteString(labelStyle.Render(title))
+	s.WriteString("\n")
+	s.WriteString(valueStyle.Render(slug))
+	s.WriteString("\n\n")
+	if size == nil {
+		s.WriteString("Size details unavailable")
+		return s.String()
+	}
+	s.WriteString(fmt.Sprintf("vCPU:  %d\n", size.Vcpus))
+	s.WriteString(fmt.Sprintf("RAM:   %.0fGB\n", float64(size.Memory)/1024.0))
+	s.WriteString(fmt.Sprintf("Disk:  %dGB\n", size.Disk))
+	s.WriteString(fmt.Sprintf("Price: $%.2f/mo", size.PriceMonthly))
+	return s.String()
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// emptyPayloadHash is the SHA-256 of an empty request body
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// s3Client is a minimal S3 client for Spaces and other S3-compatible endpoints.
+// Requests are path-style (endpoint/bucket/key) and signed with AWS Signature V4.
+type s3Client struct {
+	endpoint  *url.URL // e.g. https://fra1.digitaloceanspaces.com or http://localhost:9000
+	region    string   // Signing region, e.g. "fra1" or "us-east-1"
+	accessKey string
+	secretKey string
+	http      *http.Client
+}
+
+// s3Bucket is a bucket from ListBuckets
+type s3Bucket struct {
+	Name         string    `xml:"Name"`
+	CreationDate time.Time `xml:"CreationDate"`
+}
+
+// s3Object is an object from ListObjectsV2
+type s3Object struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+}
+
+type s3ListBucketsResult struct {
+	Buckets []s3Bucket `xml:"Buckets>Bucket"`
+}
+
+type s3ListObjectsResult struct {
+	Contents              []s3Object `xml:"Contents"`
+	CommonPrefixes        []string   `xml:"CommonPrefixes>Prefix"`
+	IsTruncated           bool       `xml:"IsTruncated"`
+	NextContinuationToken string     `xml:"NextContinuationToken"`
+}
+
+// s3Error is the error document S3 returns for failed requests
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+	status  int
+}
+
+func (e *s3Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("HTTP %d", e.status)
+	}
+	return fmt.Sprintf("%s: %s (HTTP %d)", e.Code, e.Message, e.status)
+}
+
+func newS3Client(endpoint, region, accessKey, secretKey string) (*s3Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("invalid endpoint %q, expected e.g. https://fra1.digitaloceanspaces.com", endpoint)
+	}
+	return &s3Client{
+		endpoint:  u,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		http:      &http.Client{Timeout: 30 * time.Minute}, // Long enough for large transfers
+	}, nil
+}
+
+// listBuckets returns the buckets visible at the endpoint
+func (c *s3Client) listBuckets(ctx context.Context) ([]s3Bucket, error) {
+	var result s3ListBucketsResult
+	if err := c.doXML(ctx, http.MethodGet, "", "", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Buckets, nil
+}
+
+// listObjects returns the objects and sub-prefixes directly under prefix,
+// like listing a directory
+func (c *s3Client) listObjects(ctx context.Context, bucket, prefix string) (prefixes []string, objects []s3Object, err error) {
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "delimiter": {"/"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		var result s3ListObjectsResult
+		if err := c.doXML(ctx, http.MethodGet, bucket, "", query, &result); err != nil {
+			return nil, nil, err
+		}
+		prefixes = append(prefixes, result.CommonPrefixes...)
+		for _, obj := range result.Contents {
+			// Some tools create empty "directory" objects named after the prefix
+			if obj.Key != prefix {
+				objects = append(objects, obj)
+			}
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return prefixes, objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// getObject writes the object's content to w
+func (c *s3Client) getObject(ctx context.Context, bucket, key string, w io.Writer) error {
+	resp, err := c.do(ctx, http.MethodGet, bucket, key, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// putObject uploads size bytes from body as the object
+func (c *s3Client) putObject(ctx context.Context, bucket, key string, body io.Reader, size int64) error {
+	resp, err := c.do(ctx, http.MethodPut, bucket, key, nil, body, size)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (c *s3Client) deleteObject(ctx context.Context, bucket, key string) error {
+	resp, err := c.do(ctx, http.MethodDelete, bucket, key, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// doXML sends a request without a body and decodes the XML response into v
+func (c *s3Client) doXML(ctx context.Context, method, bucket, key string, query url.Values, v any) error {
+	resp, err := c.do(ctx, method, bucket, key, query, nil, 0)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := xml.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// do sends a signed request and returns the response, or an *s3Error for non-2xx responses
+func (c *s3Client) do(ctx context.Context, method, bucket, key string, query url.Values, body io.Reader, size int64) (*http.Response, error) {
+	path := strings.TrimSuffix(c.endpoint.Path, "/") + "/"
+	if bucket != "" {
+		path += bucket
+		if key != "" {
+			path += "/" + key
+		}
+	}
+	u := *c.endpoint
+	u.Path = path
+	u.RawPath = s3EscapePath(path)
+	u.RawQuery = s3CanonicalQuery(query)
+
+	// A non-nil body of unknown length is sent chunked, which S3 rejects for
+	// uploads, so empty files go without one
+	if body != nil && size == 0 {
+		body = http.NoBody
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	payloadHash := emptyPayloadHash
+	if body != nil {
+		req.ContentLength = size
+		payloadHash = "UNSIGNED-PAYLOAD" // Uploads are streamed rather than hashed up front
+	}
+	c.sign(req, u.RawPath, payloadHash, time.Now().UTC())
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		s3Err := &s3Error{status: resp.StatusCode}
+		_ = xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(s3Err)
+		return nil, s3Err
+	}
+	return resp, nil
+}
+
+// sign adds AWS Signature V4 headers to the request
+func (c *s3Client) sign(req *http.Request, escapedPath, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		escapedPath,
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + c.region + "/s3/aws4_request"
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+c.secretKey), date)
+	signingKey = hmacSHA256(signingKey, c.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// s3Escape percent-encodes everything except unreserved characters, as SigV4 requires
+func s3Escape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// s3EscapePath escapes each segment of the path, keeping the slashes
+func s3EscapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = s3Escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// s3CanonicalQuery encodes the query sorted by key, as SigV4 requires
+func s3CanonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, s3Escape(k)+"="+s3Escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+type snapshotsLoadedMsg []godo.Snapshot
+
+// snapshotDeletedMsg reports a deleted snapshot by name
+type snapshotDeletedMsg string
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewSnapshots,
+		aliases: []string{"snapshot", "snap"},
+		title:   "Droplet Snapshots",
+		load: func(m *model) tea.Cmd {
+			return loadSnapshots(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.snapshots)
+		},
+		updateTable: (*model).updateSnapshotTable,
+		handleKey:   (*model).handleSnapshotKey,
+		keys: []keyHint{
+			{"d", "Delete"},
+			{"e", "Restore"},
+			{"b", "Rebuild"},
+		},
+		renderDetails: model.renderSnapshotDetails,
+	})
+}
+
+func loadSnapshots(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		snapshots, err := fetchDropletSnapshots(context.Background(), client, reportProgress(progressChan, client, "snapshots"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return snapshotsLoadedMsg(snapshots)
+	})
+}
+
+func deleteSnapshot(client *godo.Client, snapshot godo.Snapshot) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := client.Snapshots.Delete(context.Background(), snapshot.ID); err != nil {
+			return errMsg(fmt.Errorf("failed to delete snapshot %s: %w", snapshot.Name, err))
+		}
+		return snapshotDeletedMsg(snapshot.Name)
+	}
+}
+
+// imageTypeLabel returns a display label for a private image type, e.g. "snapshot" -> "Snapshot"
+func imageTypeLabel(imageType string) string {
+	if imageType == "" {
+		return "Image"
+	}
+	return strings.ToUpper(imageType[:1]) + imageType[1:]
+}
+
+// snapshotDroplet returns the droplet the snapshot was taken from, if it still exists
+func (m *model) snapshotDroplet(snapshot godo.Snapshot) *godo.Droplet {
+	for i := range m.droplets {
+		if strconv.Itoa(m.droplets[i].ID) == snapshot.ResourceID {
+			return &m.droplets[i]
+		}
+	}
+	return nil
+}
+
+// selectedSnapshot returns the snapshot under the cursor
+func (m *model) selectedSnapshot() (godo.Snapshot, bool) {
+	i := m.selectedIndex(len(m.snapshots))
+	if i < 0 {
+		return godo.Snapshot{}, false
+	}
+	return m.snapshots[i], true
+}
+
+func (m *model) updateSnapshotTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.25, 15},
+		{"ID", 0.12, 10},
+		{"SOURCE DROPLET", 0.18, 12},
+		{"REGIONS", 0.15, 10},
+		{"SIZE", 0.08, 7},
+		{"MIN DISK", 0.08, 8},
+		{"CREATED", 0.14, 10},
+	})
+
+	var rows []table.Row
+	for _, s := range m.snapshots {
+		source := s.ResourceID + " (destroyed)"
+		if d := m.snapshotDroplet(s); d != nil {
+			source = d.Name
+		}
+		rows = append(rows, table.Row{
+			s.Name,
+			s.ID,
+			source,
+			strings.Join(s.Regions, ","),
+			fmt.Sprintf("%.2fGB", s.SizeGigaBytes),
+			fmt.Sprintf("%dGB", s.MinDiskSize),
+			formatSnapshotTime(s.Created),
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func formatSnapshotTime(created string) string {
+	t, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		return created
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func (m *model) handleSnapshotKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "d", "D":
+		s, ok := m.selectedSnapshot()
+		if !ok {
+			return nil, true
+		}
+		m.confirm = &confirmDialog{
+			title:   "Delete Snapshot?",
+			lines:   []string{fmt.Sprintf("Snapshot: %s", s.Name), fmt.Sprintf("ID: %s", s.ID)},
+			warning: "This action cannot be undone!",
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(deleteSnapshot(m.client, s), m.spinner.Tick)
+			},
+		}
+		return nil, true
+
+	case "e", "E":
+		s, ok := m.selectedSnapshot()
+		if !ok {
+			return nil, true
+		}
+		d := m.snapshotDroplet(s)
+		if d == nil {
+			m.err = fmt.Errorf("restore: source droplet %s no longer exists, use b to rebuild another droplet", s.ResourceID)
+			return nil, true
+		}
+		m.confirmSnapshotAction(*d, s, "Restore", func(ctx context.Context, c *godo.Client, id, imageID int) (*godo.Action, *godo.Response, error) {
+			return c.DropletActions.Restore(ctx, id, imageID)
+		})
+		return nil, true
+
+	case "b", "B":
+		s, ok := m.selectedSnapshot()
+		if !ok {
+			return nil, true
+		}
+		m.openRebuildPicker(s)
+		return nil, true
+	}
+	return nil, false
+}
+
+// openRebuildPicker lets the user pick a droplet to rebuild from the snapshot.
+// Only droplets in one of the snapshot's regions with a large enough disk are offered.
+func (m *model) openRebuildPicker(s godo.Snapshot) {
+	var options []pickerOption
+	for _, d := range m.droplets {
+		if d.Region == nil || !containsString(s.Regions, d.Region.Slug) || d.Disk < s.MinDiskSize {
+			continue
+		}
+		options = append(options, pickerOption{
+			label: fmt.Sprintf("%s (%s, %s, %dGB)", d.Name, d.Region.Slug, d.SizeSlug, d.Disk),
+			value: strconv.Itoa(d.ID),
+		})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🔁 Rebuild a droplet from %s", s.Name),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			for _, d := range m.droplets {
+				if strconv.Itoa(d.ID) == option.value {
+					m.confirmSnapshotAction(d, s, "Rebuild", func(ctx context.Context, c *godo.Client, id, imageID int) (*godo.Action, *godo.Response, error) {
+						return c.DropletActions.RebuildByImageID(ctx, id, imageID)
+					})
+					break
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// confirmSnapshotAction asks before replacing the droplet's disk with the snapshot
+func (m *model) confirmSnapshotAction(d godo.Droplet, s godo.Snapshot, label string, run func(ctx context.Context, c *godo.Client, id, imageID int) (*godo.Action, *godo.Response, error)) {
+	if m.runningAction != nil {
+		m.err = fmt.Errorf("another droplet action is in progress")
+		return
+	}
+	imageID, err := strconv.Atoi(s.ID)
+	if err != nil {
+		m.err = fmt.Errorf("%s: invalid snapshot ID %q", label, s.ID)
+		return
+	}
+	m.confirm = &confirmDialog{
+		title: fmt.Sprintf("%s Droplet from Snapshot?", label),
+		lines: []string{
+			fmt.Sprintf("Droplet: %s", d.Name),
+			fmt.Sprintf("Snapshot: %s", s.Name),
+		},
+		warning: "The droplet's disk will be replaced with the snapshot. Data written since then will be lost.",
+		onConfirm: func(m *model) tea.Cmd {
+			return m.startTrackedAction(d, label+" from "+s.Name, []dropletActionStep{{label: label, run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+				return run(ctx, c, id, imageID)
+			}}})
+		},
+	}
+}
+
+// promptDropletSnapshot asks for a snapshot name and snapshots the droplet
+func (m *model) promptDropletSnapshot(d godo.Droplet) {
+	defaultName := fmt.Sprintf("%s-%s", d.Name, time.Now().Format("20060102-1504"))
+	m.input = newInputDialog("📸 Snapshot "+d.Name, "snapshot name", defaultName, func(m *model, name string) tea.Cmd {
+		cmd := m.startTrackedAction(d, "Snapshot", []dropletActionStep{{label: "Snapshot", run: func(ctx context.Context, c *godo.Client, id int) (*godo.Action, *godo.Response, error) {
+			return c.DropletActions.Snapshot(ctx, id, name)
+		}}})
+		m.runningAction.onDone = func(m *model) tea.Cmd {
+			return loadSnapshots(m.client, m.pageProgressChan)
+		}
+		return cmd
+	})
+}
+
+func (m model) renderSnapshotDetails() string {
+	s, ok := m.selectedSnapshot()
+	if !ok {
+		return ""
+	}
+	source := s.ResourceID + " (destroyed)"
+	if d := m.snapshotDroplet(s); d != nil {
+		source = fmt.Sprintf("%s (%d)", d.Name, d.ID)
+	}
+	tags := strings.Join(s.Tags, ", ")
+	if tags == "" {
+		tags = "none"
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("📸 Snapshot: %s", s.Name)))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("Snapshot", [][2]string{
+		{"ID", s.ID},
+		{"Created", formatSnapshotTime(s.Created)},
+		{"Size", fmt.Sprintf("%.2fGB", s.SizeGigaBytes)},
+		{"Min Disk", fmt.Sprintf("%dGB", s.MinDiskSize)},
+		{"Tags", tags},
+	}))
+	b.WriteString("\n")
+	b.WriteString(renderDetailsSection("Source", [][2]string{
+		{"Droplet", source},
+		{"Regions", strings.Join(s.Regions, ", ")},
+	}))
+	return b.String()
+}
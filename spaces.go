@@ -0,0 +1,525 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// spacesRegions are the regions Spaces is available in, listed when the config doesn't name any
+var spacesRegions = []string{"nyc3", "sfo2", "sfo3", "ams3", "sgp1", "fra1", "syd1", "blr1", "lon1", "tor1", "atl1"}
+
+// errSpacesNotConfigured is returned when config.yaml has no spaces section
+var errSpacesNotConfigured = errors.New("spaces: add a spaces section with access keys to config.yaml")
+
+// spacesBucket is a bucket and the region whose endpoint serves it
+type spacesBucket struct {
+	name    string
+	region  string
+	created time.Time
+}
+
+// spacesEntry is a row in the bucket browser: a sub-prefix ("folder") or an object
+type spacesEntry struct {
+	key      string // Full key, ending in "/" for prefixes
+	isPrefix bool
+	size     int64
+	modified time.Time
+	etag     string
+}
+
+// spacesBucketsLoadedMsg carries the buckets of all regions. err is set if
+// some regions failed while others succeeded.
+type spacesBucketsLoadedMsg struct {
+	buckets []spacesBucket
+	err     error
+}
+
+// spacesObjectsLoadedMsg carries the entries under a prefix
+type spacesObjectsLoadedMsg struct {
+	bucket  string
+	prefix  string
+	entries []spacesEntry
+}
+
+// spacesObjectChangedMsg reports a finished download, upload or delete
+type spacesObjectChangedMsg string
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewSpaces,
+		aliases: []string{"space", "s3", "buckets"},
+		title:   "Spaces",
+		load: func(m *model) tea.Cmd {
+			if m.spacesBucket != nil {
+				return m.loadSpacesObjects(*m.spacesBucket, m.spacesPrefix)
+			}
+			return m.loadSpacesBuckets()
+		},
+		count: func(m *model) int {
+			if m.spacesBucket != nil {
+				return len(m.spacesEntries)
+			}
+			return len(m.projectSpacesBuckets())
+		},
+		updateTable: (*model).updateSpacesTable,
+		handleKey:   (*model).handleSpacesKey,
+		keys: []keyHint{
+			{"d", "Download"},
+			{"u", "Upload"},
+			{"x", "Delete"},
+		},
+		renderDetails: model.renderSpacesObjectDetails,
+	})
+}
+
+// spacesClient returns an S3 client for the region's Spaces endpoint, or the configured endpoint
+func (m *model) spacesClient(region string) (*s3Client, error) {
+	cfg := m.spacesConfig()
+	if cfg == nil {
+		return nil, errSpacesNotConfigured
+	}
+	accessKey, secretKey, err := cfg.keys()
+	if err != nil {
+		return nil, err
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.digitaloceanspaces.com", region)
+	}
+	return newS3Client(endpoint, region, accessKey, secretKey)
+}
+
+// spacesListRegions returns the regions to list buckets in. A custom endpoint is a single
+// region, named by the first configured region (S3 servers like MinIO default to us-east-1).
+func (m *model) spacesListRegions() []string {
+	cfg := m.spacesConfig()
+	if cfg.Endpoint != "" {
+		if len(cfg.Regions) > 0 {
+			return cfg.Regions[:1]
+		}
+		return []string{"us-east-1"}
+	}
+	if len(cfg.Regions) > 0 {
+		return cfg.Regions
+	}
+	return spacesRegions
+}
+
+// loadSpacesBuckets lists the buckets of every region in parallel
+func (m *model) loadSpacesBuckets() tea.Cmd {
+	if m.spacesConfig() == nil {
+		return func() tea.Msg { return errMsg(errSpacesNotConfigured) }
+	}
+	regions := m.spacesListRegions()
+	clients := make([]*s3Client, len(regions))
+	for i, region := range regions {
+		client, err := m.spacesClient(region)
+		if err != nil {
+			return func() tea.Msg { return errMsg(err) }
+		}
+		clients[i] = client
+	}
+
+	return withClient(m.client, func() tea.Msg {
+		ctx := context.Background()
+		results := make([][]s3Bucket, len(clients))
+		errs := make([]error, len(clients))
+		var wg sync.WaitGroup
+		for i, client := range clients {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], errs[i] = client.listBuckets(ctx)
+			}()
+		}
+		wg.Wait()
+
+		// Endpoints may list buckets of other regions too, so a bucket listed
+		// by more than one region is kept for the first region only
+		seen := map[string]bool{}
+		var buckets []spacesBucket
+		var failed []string
+		var firstErr error
+		for i, region := range regions {
+			if errs[i] != nil {
+				failed = append(failed, region)
+				if firstErr == nil {
+					firstErr = errs[i]
+				}
+				continue
+			}
+			for _, b := range results[i] {
+				if seen[b.Name] {
+					continue
+				}
+				seen[b.Name] = true
+				buckets = append(buckets, spacesBucket{name: b.Name, region: region, created: b.CreationDate})
+			}
+		}
+		sort.Slice(buckets, func(i, j int) bool {
+			if buckets[i].region != buckets[j].region {
+				return buckets[i].region < buckets[j].region
+			}
+			return buckets[i].name < buckets[j].name
+		})
+
+		if firstErr != nil {
+			err := fmt.Errorf("failed to list buckets in %s: %w", strings.Join(failed, ", "), firstErr)
+			if len(failed) == len(regions) {
+				return errMsg(err)
+			}
+			return spacesBucketsLoadedMsg{buckets: buckets, err: err}
+		}
+		return spacesBucketsLoadedMsg{buckets: buckets}
+	})
+}
+
+// loadSpacesObjects lists the prefixes and objects directly under prefix
+func (m *model) loadSpacesObjects(bucket spacesBucket, prefix string) tea.Cmd {
+	client, err := m.spacesClient(bucket.region)
+	if err != nil {
+		return func() tea.Msg { return errMsg(err) }
+	}
+	return withClient(m.client, func() tea.Msg {
+		prefixes, objects, err := client.listObjects(context.Background(), bucket.name, prefix)
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to list %s/%s: %w", bucket.name, prefix, err))
+		}
+		entries := make([]spacesEntry, 0, len(prefixes)+len(objects))
+		for _, p := range prefixes {
+			entries = append(entries, spacesEntry{key: p, isPrefix: true})
+		}
+		for _, obj := range objects {
+			entries = append(entries, spacesEntry{
+				key:      obj.Key,
+				size:     obj.Size,
+				modified: obj.LastModified,
+				etag:     strings.Trim(obj.ETag, `"`),
+			})
+		}
+		return spacesObjectsLoadedMsg{bucket: bucket.name, prefix: prefix, entries: entries}
+	})
+}
+
+func downloadSpacesObject(client *s3Client, bucket, key, dest string) tea.Cmd {
+	return func() tea.Msg {
+		f, err := os.Create(dest)
+		if err != nil {
+			return errMsg(fmt.Errorf("download: %w", err))
+		}
+		err = client.getObject(context.Background(), bucket, key, f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(dest) // Don't leave a partial file behind
+			return errMsg(fmt.Errorf("failed to download %s: %w", key, err))
+		}
+		return spacesObjectChangedMsg(fmt.Sprintf("Downloaded %s to %s", key, dest))
+	}
+}
+
+func uploadSpacesObject(client *s3Client, bucket, key, src string) tea.Cmd {
+	return func() tea.Msg {
+		f, err := os.Open(src)
+		if err != nil {
+			return errMsg(fmt.Errorf("upload: %w", err))
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			return errMsg(fmt.Errorf("upload: %w", err))
+		}
+		if err := client.putObject(context.Background(), bucket, key, f, info.Size()); err != nil {
+			return errMsg(fmt.Errorf("failed to upload %s: %w", key, err))
+		}
+		return spacesObjectChangedMsg(fmt.Sprintf("Uploaded %s to %s/%s", filepath.Base(src), bucket, key))
+	}
+}
+
+func deleteSpacesObject(client *s3Client, bucket, key string) tea.Cmd {
+	return func() tea.Msg {
+		if err := client.deleteObject(context.Background(), bucket, key); err != nil {
+			return errMsg(fmt.Errorf("failed to delete %s: %w", key, err))
+		}
+		return spacesObjectChangedMsg(fmt.Sprintf("Deleted %s/%s", bucket, key))
+	}
+}
+
+// formatByteSize returns a human-readable size, e.g. 1536 -> "1.5 KiB"
+func formatByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// parentPrefix returns the prefix one level up, e.g. "a/b/" -> "a/"
+func parentPrefix(prefix string) string {
+	trimmed := strings.TrimSuffix(prefix, "/")
+	if i := strings.LastIndex(trimmed, "/"); i >= 0 {
+		return trimmed[:i+1]
+	}
+	return ""
+}
+
+// downloadDir is where downloads go by default: ~/Downloads if it exists, else the working directory
+func downloadDir() string {
+	if home, err := os.UserHomeDir(); err == nil {
+		dir := filepath.Join(home, "Downloads")
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+	}
+	return "."
+}
+
+// selectedSpacesEntry returns the entry under the cursor in the bucket browser
+func (m *model) selectedSpacesEntry() (spacesEntry, bool) {
+	if m.spacesBucket == nil {
+		return spacesEntry{}, false
+	}
+	i := m.selectedIndex(len(m.spacesEntries))
+	if i < 0 {
+		return spacesEntry{}, false
+	}
+	return m.spacesEntries[i], true
+}
+
+// openSpacesPrefix shows the entries under prefix, or the bucket list if bucket is nil
+func (m *model) openSpacesPrefix(bucket *spacesBucket, prefix string) tea.Cmd {
+	m.spacesBucket = bucket
+	m.spacesPrefix = prefix
+	m.spacesEntries = nil
+	m.table.SetCursor(0)
+	m.updateTableRows()
+	if bucket == nil {
+		return nil
+	}
+	m.loading = true
+	return tea.Batch(m.loadSpacesObjects(*bucket, prefix), m.spinner.Tick)
+}
+
+func (m *model) updateSpacesTable() {
+	if m.spacesBucket == nil {
+		columns := responsiveColumns(m.width, []columnSpec{
+			{"NAME", 0.5, 20},
+			{"REGION", 0.2, 8},
+			{"CREATED", 0.3, 16},
+		})
+		var rows []table.Row
+		for _, b := range m.projectSpacesBuckets() {
+			rows = append(rows, table.Row{b.name, b.region, b.created.Local().Format("2006-01-02 15:04")})
+		}
+		m.setTableData(columns, rows)
+		return
+	}
+
+	// The name column shows where we are, like a shell prompt
+	columns := responsiveColumns(m.width, []columnSpec{
+		{m.spacesBucket.name + "/" + m.spacesPrefix, 0.45, 20},
+		{"SIZE", 0.12, 9},
+		{"MODIFIED", 0.18, 16},
+		{"ETAG", 0.25, 12},
+	})
+	var rows []table.Row
+	for _, e := range m.spacesEntries {
+		name := strings.TrimPrefix(e.key, m.spacesPrefix)
+		if e.isPrefix {
+			rows = append(rows, table.Row{"📁 " + name, "-", "-", "-"})
+			continue
+		}
+		rows = append(rows, table.Row{name, formatByteSize(e.size), e.modified.Local().Format("2006-01-02 15:04"), e.etag})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleSpacesKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "enter":
+		if m.spacesBucket == nil {
+			buckets := m.projectSpacesBuckets()
+			i := m.selectedIndex(len(buckets))
+			if i < 0 {
+				return nil, true
+			}
+			bucket := buckets[i]
+			return m.openSpacesPrefix(&bucket, ""), true
+		}
+		e, ok := m.selectedSpacesEntry()
+		if !ok {
+			return nil, true
+		}
+		if e.isPrefix {
+			return m.openSpacesPrefix(m.spacesBucket, e.key), true
+		}
+		return nil, false // Object details
+
+	case "esc", "backspace":
+		if m.spacesBucket == nil {
+			return nil, false
+		}
+		// Up one level, then back to the bucket list
+		if m.spacesPrefix == "" {
+			return m.openSpacesPrefix(nil, ""), true
+		}
+		return m.openSpacesPrefix(m.spacesBucket, parentPrefix(m.spacesPrefix)), true
+
+	case "d", "D":
+		e, ok := m.selectedSpacesEntry()
+		if !ok || e.isPrefix {
+			m.err = fmt.Errorf("download: select an object first")
+			return nil, true
+		}
+		m.promptSpacesDownload(*m.spacesBucket, e)
+		return nil, true
+
+	case "u", "U":
+		if m.spacesBucket == nil {
+			m.err = fmt.Errorf("upload: press enter to open a bucket first")
+			return nil, true
+		}
+		m.promptSpacesUpload(*m.spacesBucket, m.spacesPrefix)
+		return nil, true
+
+	case "x", "X":
+		e, ok := m.selectedSpacesEntry()
+		if !ok || e.isPrefix {
+			m.err = fmt.Errorf("delete: select an object first")
+			return nil, true
+		}
+		bucket := *m.spacesBucket
+		m.confirm = &confirmDialog{
+			title: "Delete Object?",
+			lines: []string{
+				fmt.Sprintf("Object: %s/%s", bucket.name, e.key),
+				fmt.Sprintf("Size: %s", formatByteSize(e.size)),
+			},
+			warning: "This action cannot be undone!",
+			onConfirm: func(m *model) tea.Cmd {
+				client, err := m.spacesClient(bucket.region)
+				if err != nil {
+					m.err = err
+					return nil
+				}
+				m.loading = true
+				return tea.Batch(deleteSpacesObject(client, bucket.name, e.key), m.spinner.Tick)
+			},
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// promptSpacesDownload asks where to save the object, confirming before overwriting a local file
+func (m *model) promptSpacesDownload(bucket spacesBucket, e spacesEntry) {
+	defaultDest := filepath.Join(downloadDir(), path.Base(e.key))
+	m.input = newInputDialog("⬇️  Download "+path.Base(e.key), "local file path", defaultDest, func(m *model, dest string) tea.Cmd {
+		dest = expandHome(dest)
+		if info, err := os.Stat(dest); err == nil && info.IsDir() {
+			dest = filepath.Join(dest, path.Base(e.key))
+		}
+		client, err := m.spacesClient(bucket.region)
+		if err != nil {
+			m.err = err
+			return nil
+		}
+		download := func(m *model) tea.Cmd {
+			m.loading = true
+			return tea.Batch(downloadSpacesObject(client, bucket.name, e.key, dest), m.spinner.Tick)
+		}
+		if _, err := os.Stat(dest); err == nil {
+			m.confirm = &confirmDialog{
+				title:     "Overwrite Local File?",
+				lines:     []string{fmt.Sprintf("Object: %s/%s (%s)", bucket.name, e.key, formatByteSize(e.size)), fmt.Sprintf("File: %s", dest)},
+				warning:   "The existing file will be replaced.",
+				onConfirm: download,
+			}
+			return nil
+		}
+		return download(m)
+	})
+}
+
+// promptSpacesUpload asks for a local file and the key to upload it as, then confirms
+func (m *model) promptSpacesUpload(bucket spacesBucket, prefix string) {
+	m.input = newInputDialog(fmt.Sprintf("⬆️  Upload to %s/%s", bucket.name, prefix), "local file path", "", func(m *model, src string) tea.Cmd {
+		src = expandHome(src)
+		info, err := os.Stat(src)
+		if err != nil {
+			m.err = fmt.Errorf("upload: %w", err)
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			m.err = fmt.Errorf("upload: %s is not a regular file", src)
+			return nil
+		}
+		m.input = newInputDialog("⬆️  Object key", "e.g. backups/db.sql.gz", prefix+filepath.Base(src), func(m *model, key string) tea.Cmd {
+			key = strings.TrimPrefix(key, "/")
+			warning := ""
+			for _, e := range m.spacesEntries {
+				if !e.isPrefix && e.key == key {
+					warning = fmt.Sprintf("The existing object (%s) will be replaced.", formatByteSize(e.size))
+					break
+				}
+			}
+			m.confirm = &confirmDialog{
+				title: "Upload File?",
+				lines: []string{
+					fmt.Sprintf("File: %s (%s)", src, formatByteSize(info.Size())),
+					fmt.Sprintf("Object: %s/%s", bucket.name, key),
+				},
+				warning: warning,
+				onConfirm: func(m *model) tea.Cmd {
+					client, err := m.spacesClient(bucket.region)
+					if err != nil {
+						m.err = err
+						return nil
+					}
+					m.loading = true
+					return tea.Batch(uploadSpacesObject(client, bucket.name, key, src), m.spinner.Tick)
+				},
+			}
+			return nil
+		})
+		return nil
+	})
+}
+
+func (m model) renderSpacesObjectDetails() string {
+	e, ok := m.selectedSpacesEntry()
+	if !ok || e.isPrefix {
+		return ""
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("🪣 Object: %s", path.Base(e.key))))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("Object", [][2]string{
+		{"Bucket", m.spacesBucket.name},
+		{"Region", m.spacesBucket.region},
+		{"Key", e.key},
+		{"Size", fmt.Sprintf("%s (%d bytes)", formatByteSize(e.size), e.size)},
+		{"Modified", e.modified.Local().Format("2006-01-02 15:04:05")},
+		{"ETag", e.etag},
+	}))
+	return b.String()
+}
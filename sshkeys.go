@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+type sshKeysLoadedMsg []godo.Key
+
+// sshKeyChangedMsg reports an imported or deleted key
+type sshKeyChangedMsg string
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewSSHKeys,
+		aliases: []string{"sshkey", "keys"},
+		title:   "SSH Keys",
+		load: func(m *model) tea.Cmd {
+			return loadSSHKeys(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.sshKeys)
+		},
+		updateTable: (*model).updateSSHKeyTable,
+		handleKey:   (*model).handleSSHKeyKey,
+		keys: []keyHint{
+			{"i", "Import"},
+			{"d", "Delete"},
+		},
+		renderDetails: model.renderSSHKeyDetails,
+	})
+}
+
+func loadSSHKeys(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		keys, err := fetchSSHKeys(context.Background(), client, reportProgress(progressChan, client, "SSH keys"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return sshKeysLoadedMsg(keys)
+	})
+}
+
+func importSSHKey(client *godo.Client, name, publicKey string) tea.Cmd {
+	return func() tea.Msg {
+		key, _, err := client.Keys.Create(context.Background(), &godo.KeyCreateRequest{Name: name, PublicKey: publicKey})
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to import SSH key %s: %w", name, err))
+		}
+		return sshKeyChangedMsg(fmt.Sprintf("✅ Imported SSH key '%s' (%s)", key.Name, key.Fingerprint))
+	}
+}
+
+func deleteSSHKey(client *godo.Client, key godo.Key) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := client.Keys.DeleteByID(context.Background(), key.ID); err != nil {
+			return errMsg(fmt.Errorf("failed to delete SSH key %s: %w", key.Name, err))
+		}
+		return sshKeyChangedMsg(fmt.Sprintf("✅ SSH key '%s' deleted", key.Name))
+	}
+}
+
+// localPublicKey is a public key file found in ~/.ssh
+type localPublicKey struct {
+	path    string
+	key     string // The key line, e.g. "ssh-ed25519 AAAA... me@laptop"
+	kind    string // e.g. "ssh-ed25519"
+	comment string // e.g. "me@laptop", "" if none
+}
+
+// localPublicKeys returns the public keys in ~/.ssh/*.pub
+func localPublicKeys() ([]localPublicKey, error) {
+	paths, err := filepath.Glob(expandHome("~/.ssh/*.pub"))
+	if err != nil {
+		return nil, err
+	}
+	var keys []localPublicKey
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		line := strings.TrimSpace(string(data))
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		key := localPublicKey{path: path, key: line, kind: fields[0]}
+		if len(fields) > 2 {
+			key.comment = strings.Join(fields[2:], " ")
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// sshKeyType returns the key type from a public key, e.g. "ssh-ed25519"
+func sshKeyType(publicKey string) string {
+	if fields := strings.Fields(publicKey); len(fields) > 0 {
+		return fields[0]
+	}
+	return "-"
+}
+
+// sshKeyImported returns the account key with the same public key as a local one, or nil
+func (m *model) sshKeyImported(local localPublicKey) *godo.Key {
+	fields := strings.Fields(local.key)
+	for i, k := range m.sshKeys {
+		if kf := strings.Fields(k.PublicKey); len(kf) > 1 && kf[1] == fields[1] {
+			return &m.sshKeys[i]
+		}
+	}
+	return nil
+}
+
+func (m *model) selectedSSHKey() (godo.Key, bool) {
+	i := m.selectedIndex(len(m.sshKeys))
+	if i < 0 {
+		return godo.Key{}, false
+	}
+	return m.sshKeys[i], true
+}
+
+func (m *model) updateSSHKeyTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.25, 12},
+		{"ID", 0.12, 8},
+		{"TYPE", 0.15, 10},
+		{"FINGERPRINT", 0.38, 20},
+		{"DEFAULT", 0.10, 7},
+	})
+	var rows []table.Row
+	for _, k := range m.sshKeys {
+		isDefault := ""
+		if m.configSSHKey(k) {
+			isDefault = "●"
+		}
+		rows = append(rows, table.Row{k.Name, strconv.Itoa(k.ID), sshKeyType(k.PublicKey), k.Fingerprint, isDefault})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleSSHKeyKey(key string) (tea.Cmd, bool) {
+	switch key {
+	case "i", "I":
+		m.openSSHKeyImportPicker()
+		return nil, true
+
+	case "d", "D":
+		k, ok := m.selectedSSHKey()
+		if !ok {
+			return nil, true
+		}
+		lines := []string{
+			fmt.Sprintf("Key: %s (ID: %d)", k.Name, k.ID),
+			fmt.Sprintf("Fingerprint: %s", k.Fingerprint),
+		}
+		if m.configSSHKey(k) {
+			lines = append(lines, "This key is a create form default in config.yaml.")
+		}
+		m.confirm = &confirmDialog{
+			title:   "Delete SSH Key?",
+			lines:   lines,
+			warning: "Existing droplets keep the key, but new droplets can't be created with it.",
+			onConfirm: func(m *model) tea.Cmd {
+				m.loading = true
+				return tea.Batch(deleteSSHKey(m.client, k), m.spinner.Tick)
+			},
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// openSSHKeyImportPicker lists ~/.ssh/*.pub, then asks for a name for the picked key
+func (m *model) openSSHKeyImportPicker() {
+	locals, err := localPublicKeys()
+	if err != nil {
+		m.err = fmt.Errorf("import: %w", err)
+		return
+	}
+	if len(locals) == 0 {
+		m.err = fmt.Errorf("import: no public keys found in ~/.ssh/*.pub")
+		return
+	}
+	options := make([]pickerOption, len(locals))
+	for i, local := range locals {
+		label := fmt.Sprintf("%s (%s)", filepath.Base(local.path), local.kind)
+		if local.comment != "" {
+			label += " " + local.comment
+		}
+		if existing := m.sshKeyImported(local); existing != nil {
+			label += fmt.Sprintf(" - imported as %s", existing.Name)
+		}
+		options[i] = pickerOption{label: label, value: strconv.Itoa(i)}
+	}
+	m.picker = &pickerDialog{
+		title:   "🔑 Import SSH key",
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			i, _ := strconv.Atoi(option.value)
+			local := locals[i]
+			if existing := m.sshKeyImported(local); existing != nil {
+				m.err = fmt.Errorf("import: %s is already in the account as %s", filepath.Base(local.path), existing.Name)
+				return nil
+			}
+			name := local.comment
+			if name == "" {
+				name = strings.TrimSuffix(filepath.Base(local.path), ".pub")
+			}
+			m.input = newInputDialog("🔑 Key name", "e.g. laptop", name, func(m *model, name string) tea.Cmd {
+				if name == "" {
+					m.err = fmt.Errorf("import: the key needs a name")
+					return nil
+				}
+				m.loading = true
+				return tea.Batch(importSSHKey(m.client, name, local.key), m.spinner.Tick)
+			})
+			return nil
+		},
+	}
+}
+
+// configSSHKey reports whether the key is one of the create form defaults in config.yaml
+func (m *model) configSSHKey(k godo.Key) bool {
+	if m.config == nil {
+		return false
+	}
+	for _, ref := range m.config.SSHKeys {
+		if findSSHKey([]godo.Key{k}, ref) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// sshKeySelected reports whether the key is selected in the create form
+func (m *model) sshKeySelected(k godo.Key) bool {
+	for _, ref := range m.selectedSSHKeys {
+		if findSSHKey([]godo.Key{k}, ref) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// toggleSSHKey adds or removes the key from the create form's selection
+func (m *model) toggleSSHKey(k godo.Key) {
+	if !m.sshKeySelected(k) {
+		m.selectedSSHKeys = append(m.selectedSSHKeys, k.Fingerprint)
+		return
+	}
+	var refs []string
+	for _, ref := range m.selectedSSHKeys {
+		if findSSHKey([]godo.Key{k}, ref) == nil {
+			refs = append(refs, ref)
+		}
+	}
+	m.selectedSSHKeys = refs
+}
+
+// selectedSSHKeysLabel lists the create form's keys by name, as far as they are loaded
+func (m *model) selectedSSHKeysLabel() string {
+	names := make([]string, len(m.selectedSSHKeys))
+	for i, ref := range m.selectedSSHKeys {
+		names[i] = ref
+		if k := findSSHKey(m.sshKeys, ref); k != nil {
+			names[i] = k.Name
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+func (m model) renderSSHKeyDetails() string {
+	k, ok := m.selectedSSHKey()
+	if !ok {
+		return ""
+	}
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("🔑 SSH Key: %s", k.Name)))
+	b.WriteString("\n\n")
+	isDefault := "No"
+	if m.configSSHKey(k) {
+		isDefault = "Yes (selected in the create form by default)"
+	}
+	b.WriteString(renderDetailsSection("Key", [][2]string{
+		{"ID", strconv.Itoa(k.ID)},
+		{"Type", sshKeyType(k.PublicKey)},
+		{"Fingerprint", k.Fingerprint},
+		{"Create default", isDefault},
+	}))
+	b.WriteString(headerStyle.Render("Public Key"))
+	b.WriteString("\n")
+	// Wrap the key so it can be read and copied in full
+	width := max(20, m.width-4)
+	for key := k.PublicKey; key != ""; {
+		n := min(width, len(key))
+		b.WriteString(valueStyle.Render(key[:n]))
+		b.WriteString("\n")
+		key = key[n:]
+	}
+	return b.String()
+}
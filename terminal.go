@@ -0,0 +1,433 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/cliofy/govte"
+	"github.com/cliofy/govte/terminal"
+)
+
+// terminalSession is a full-screen interactive terminal: a VT emulator fed by
+// a backend process (ssh on a local PTY, or a shell exec'd in a pod) that
+// receives all keyboard input. Shared by pointer between model copies.
+type terminalSession struct {
+	title       string                   // Header, e.g. "🔌 Connected to: web-1"
+	detail      string                   // Header detail, e.g. "IP: 203.0.113.10"
+	reminder    string                   // Start of the help line, e.g. "Host: web-1"
+	exitLabel   string                   // What esc does, e.g. "Exit SSH"
+	closePrompt string                   // Exit confirmation question
+	connecting  string                   // Shown until the first output arrives
+	emulator    *terminal.TerminalBuffer // Terminal emulator - SINGLE SOURCE OF TRUTH for rendering
+	parser      *govte.Parser            // Parser for ANSI escape sequences
+	rawOutput   strings.Builder          // Raw terminal output buffer (for debugging only, not used for display)
+	mu          sync.Mutex               // Guards the emulator between output and rendering
+	confirmExit bool                     // When true, show exit confirmation dialog
+	backend     *terminalBackend         // Process behind the terminal (nil until started)
+}
+
+// terminalBackend is the process behind a terminal session
+type terminalBackend struct {
+	stdin  io.Writer            // Keyboard input goes here
+	resize func(cols, rows int) // Tells the process the terminal size
+	close  func()               // Stops the process
+}
+
+// terminalStartedMsg is sent when a terminal's process starts
+type terminalStartedMsg struct {
+	session *terminalSession
+	backend *terminalBackend
+}
+
+// terminalOutputMsg is output from a terminal's process
+type terminalOutputMsg struct {
+	session *terminalSession
+	data    string
+}
+
+// terminalClosedMsg is sent when a terminal's process exits
+type terminalClosedMsg struct {
+	session *terminalSession
+	err     error // Why it exited, nil for a normal exit
+}
+
+// newTerminalSession creates a terminal session with an emulator of the given size
+func newTerminalSession(cols, rows int) *terminalSession {
+	// Create terminal buffer with exact display dimensions
+	// This ensures cursor positioning and screen updates work correctly
+	return &terminalSession{
+		emulator: terminal.NewTerminalBuffer(cols, rows),
+		parser:   govte.NewParser(),
+	}
+}
+
+// terminalSize returns the size of the terminal display area, which the
+// emulator and the process's terminal must match exactly
+func (m *model) terminalSize() (cols, rows int) {
+	rows = m.height - getTopPadding() - 6 // Header + padding + help text
+	if rows < 5 {
+		rows = 5
+	}
+	cols = m.width - 4 // Account for border and padding
+	if cols < 40 {
+		cols = 40
+	}
+	return cols, rows
+}
+
+// resizeTerminal makes the process and emulator match the display area
+// CRITICAL: ncurses apps need accurate terminal size for proper rendering
+func (m *model) resizeTerminal() {
+	s := m.terminal
+	if s == nil || s.backend == nil {
+		return
+	}
+	cols, rows := m.terminalSize()
+	s.backend.resize(cols, rows)
+	s.mu.Lock()
+	s.emulator.Resize(cols, rows)
+	s.mu.Unlock()
+}
+
+// updateTerminalMsg handles messages from the terminal's process
+func (m *model) updateTerminalMsg(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case terminalStartedMsg:
+		if msg.session != m.terminal {
+			// Closed before its process started
+			msg.backend.close()
+			return m, nil
+		}
+		m.terminal.backend = msg.backend
+		// Set the size immediately - ncurses apps need it from the start
+		m.resizeTerminal()
+		// Start polling immediately with a fast ticker
+		return m, waitForTerminalOutput(m.terminalOutputChan)
+
+	case terminalOutputMsg:
+		if msg.session == m.terminal {
+			msg.session.write(msg.data)
+		}
+		// Continue waiting for more output
+		if m.terminal != nil {
+			return m, waitForTerminalOutput(m.terminalOutputChan)
+		}
+
+	case terminalClosedMsg:
+		if msg.session == m.terminal {
+			if msg.err != nil {
+				m.err = msg.err
+			}
+			m.closeTerminal()
+		}
+	}
+	return m, nil
+}
+
+// write processes output through the terminal emulator
+func (s *terminalSession) write(output string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(output) == 0 {
+		return
+	}
+	// CRITICAL: Use VTE to interpret ANSI escape sequences properly, feeding it
+	// ALL bytes including \n, \r and control characters. The parser updates the
+	// screen buffer and cursor position, which are all that is rendered.
+	s.parser.Advance(s.emulator, []byte(output))
+
+	// Keep raw output only for debugging (keep the last 1MB)
+	s.rawOutput.WriteString(output)
+	if s.rawOutput.Len() > 1024*1024 {
+		raw := s.rawOutput.String()
+		s.rawOutput.Reset()
+		s.rawOutput.WriteString(raw[len(raw)-1024*1024:])
+	}
+}
+
+// waitForTerminalOutput waits for messages from the terminal output channel
+// Uses a continuous ticker to poll the channel non-blockingly
+func waitForTerminalOutput(outputChan <-chan tea.Msg) tea.Cmd {
+	return tea.Tick(50*time.Millisecond, func(t time.Time) tea.Msg {
+		select {
+		case msg := <-outputChan:
+			// Got a message, return it
+			return msg
+		default:
+			// No message yet, return time to keep ticker running
+			return t
+		}
+	})
+}
+
+// closeTerminal stops the terminal's process and closes the terminal view
+func (m *model) closeTerminal() {
+	if m.terminal != nil && m.terminal.backend != nil {
+		m.terminal.backend.close()
+	}
+	m.terminal = nil
+	// Clear any pending messages from the channel
+	for {
+		select {
+		case <-m.terminalOutputChan:
+		default:
+			return
+		}
+	}
+}
+
+// updateTerminal handles keyboard input for the terminal
+func (m *model) updateTerminal(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Handle exit confirmation dialog
+	if m.terminal.confirmExit {
+		switch msg.String() {
+		case "y", "Y":
+			// Confirm exit
+			m.closeTerminal()
+		case "n", "N", "esc":
+			// Cancel exit
+			m.terminal.confirmExit = false
+		}
+		return m, nil
+	}
+
+	// Esc shows the exit confirmation dialog
+	if msg.Type == tea.KeyEsc {
+		m.terminal.confirmExit = true
+		return m, nil
+	}
+
+	// NO LOCAL SCROLLING - terminal emulator IS the screen buffer
+	// All other keys (including pageup/pagedown) are forwarded to the process
+	if m.terminal.backend == nil {
+		// Process not started yet - ignore input
+		return m, nil
+	}
+	if keyBytes := terminalKeyBytes(msg); len(keyBytes) > 0 {
+		if _, err := m.terminal.backend.stdin.Write(keyBytes); err != nil {
+			// Write failed - the connection might be closed
+			m.err = fmt.Errorf("failed to write to terminal: %v", err)
+			m.closeTerminal()
+		}
+	}
+	return m, nil
+}
+
+// terminalKeyBytes returns the bytes a terminal sends for the key
+func terminalKeyBytes(msg tea.KeyMsg) []byte {
+	// CRITICAL: Check for special control keys FIRST
+	// These must be handled before checking runes
+	switch msg.Type {
+	case tea.KeyEnter:
+		// Enter key - send carriage return to execute command
+		return []byte("\r")
+	case tea.KeyBackspace:
+		// Backspace - send DEL (0x7f) for proper terminal behavior
+		// Note: \b (0x08) is backspace, but terminals expect DEL (0x7f)
+		return []byte{0x7f}
+	case tea.KeyTab:
+		return []byte("\t")
+	case tea.KeySpace:
+		return []byte(" ")
+	case tea.KeyCtrlC:
+		// Ctrl+C - send SIGINT (ASCII 3) to terminate remote process
+		return []byte{3}
+	case tea.KeyCtrlD:
+		// Ctrl+D - send EOF (ASCII 4) to close input stream
+		return []byte{4}
+	case tea.KeyCtrlZ:
+		// Ctrl+Z - send SUSP (ASCII 26) to suspend process
+		return []byte{26}
+	case tea.KeyUp, tea.KeyDown, tea.KeyLeft, tea.KeyRight, tea.KeyDelete:
+		// Arrow keys - critical for command history and interactive programs like htop
+		return getANSISequence(msg.Type)
+	case tea.KeyF1, tea.KeyF2, tea.KeyF3, tea.KeyF4, tea.KeyF5, tea.KeyF6,
+		tea.KeyF7, tea.KeyF8, tea.KeyF9, tea.KeyF10, tea.KeyF11, tea.KeyF12:
+		// Function keys - needed for htop, vim, etc.
+		return getFunctionKeySequence(msg.Type)
+	}
+
+	// Regular character input - convert runes to UTF-8 bytes
+	if len(msg.Runes) > 0 {
+		return []byte(string(msg.Runes))
+	}
+	// Fallback: try string representation for single characters
+	if keyStr := msg.String(); len(keyStr) == 1 {
+		return []byte(keyStr)
+	}
+	if msg.Type >= tea.KeyCtrlA && msg.Type <= tea.KeyCtrlZ {
+		// Ctrl+letter combinations (Ctrl+A = 1, Ctrl+B = 2, etc.)
+		return []byte{byte(msg.Type - tea.KeyCtrlA + 1)}
+	}
+	return nil
+}
+
+// getANSISequence returns ANSI escape sequence for special keys
+func getANSISequence(key tea.KeyType) []byte {
+	switch key {
+	case tea.KeyUp:
+		return []byte("\x1b[A")
+	case tea.KeyDown:
+		return []byte("\x1b[B")
+	case tea.KeyRight:
+		return []byte("\x1b[C")
+	case tea.KeyLeft:
+		return []byte("\x1b[D")
+	case tea.KeyHome:
+		return []byte("\x1b[H")
+	case tea.KeyEnd:
+		return []byte("\x1b[F")
+	case tea.KeyPgUp:
+		return []byte("\x1b[5~")
+	case tea.KeyPgDown:
+		return []byte("\x1b[6~")
+	case tea.KeyDelete:
+		return []byte("\x1b[3~")
+	default:
+		return nil
+	}
+}
+
+// getFunctionKeySequence returns ANSI escape sequence for function keys (F1-F12)
+func getFunctionKeySequence(key tea.KeyType) []byte {
+	switch key {
+	case tea.KeyF1:
+		return []byte("\x1bOP")
+	case tea.KeyF2:
+		return []byte("\x1bOQ")
+	case tea.KeyF3:
+		return []byte("\x1bOR")
+	case tea.KeyF4:
+		return []byte("\x1bOS")
+	case tea.KeyF5:
+		return []byte("\x1b[15~")
+	case tea.KeyF6:
+		return []byte("\x1b[17~")
+	case tea.KeyF7:
+		return []byte("\x1b[18~")
+	case tea.KeyF8:
+		return []byte("\x1b[19~")
+	case tea.KeyF9:
+		return []byte("\x1b[20~")
+	case tea.KeyF10:
+		return []byte("\x1b[21~")
+	case tea.KeyF11:
+		return []byte("\x1b[23~")
+	case tea.KeyF12:
+		return []byte("\x1b[24~")
+	default:
+		return nil
+	}
+}
+
+// renderTerminalHeader renders the prominent header naming what the terminal is connected to
+func (m model) renderTerminalHeader() string {
+	headerStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(primaryColor).
+		Padding(1, 2).
+		Width(m.width - 2).
+		Foreground(lipgloss.Color("255")).
+		Background(primaryColor).
+		Bold(true)
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("255")).
+		Bold(true).
+		Background(primaryColor)
+
+	detailStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("229")).
+		Background(primaryColor)
+
+	return headerStyle.Render(fmt.Sprintf("%s  |  %s",
+		titleStyle.Render(m.terminal.title),
+		detailStyle.Render(m.terminal.detail)))
+}
+
+func (m model) renderTerminal() string {
+	s := m.terminal
+	if s.confirmExit {
+		return m.renderTerminalExitConfirmation()
+	}
+
+	var b strings.Builder
+	b.WriteString(m.renderTerminalHeader())
+	b.WriteString("\n")
+
+	// CRITICAL: Display dimensions must match the process and emulator sizes exactly
+	displayCols, displayRows := m.terminalSize()
+
+	// Render the emulator's screen buffer exactly as-is - no line manipulation
+	s.mu.Lock()
+	var lines []string
+	if s.backend != nil {
+		// Size mismatch causes cursor positioning errors, broken rendering, etc.
+		if currentWidth, currentHeight := s.emulator.Dimensions(); currentWidth != displayCols || currentHeight != displayRows {
+			s.emulator.Resize(displayCols, displayRows)
+		}
+		// GetDisplayWithColors() returns each screen row as one line with ANSI color codes
+		lines = strings.Split(s.emulator.GetDisplayWithColors(), "\n")
+		// Remove empty trailing line if present
+		if len(lines) > 0 && lines[len(lines)-1] == "" {
+			lines = lines[:len(lines)-1]
+		}
+		// CRITICAL: We MUST have exactly displayRows lines
+		if len(lines) > displayRows {
+			// Take only the last displayRows lines (most recent screen content)
+			lines = lines[len(lines)-displayRows:]
+		}
+	} else {
+		// Process not started yet - show connection message
+		lines = []string{s.connecting}
+	}
+	s.mu.Unlock()
+	for len(lines) < displayRows {
+		// Pad with empty lines (screen hasn't filled all rows yet)
+		lines = append(lines, "")
+	}
+
+	terminalBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1, 1).
+		Render(strings.Join(lines, "\n"))
+
+	b.WriteString(terminalBox)
+	b.WriteString("\n\n")
+
+	helpText := helpStyle.Render(fmt.Sprintf("%s  |  [ctrl+c] Terminate Process  [esc] %s",
+		s.reminder, s.exitLabel))
+	b.WriteString(helpText)
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// renderTerminalExitConfirmation renders the exit confirmation dialog
+func (m model) renderTerminalExitConfirmation() string {
+	var b strings.Builder
+
+	// Prominent header still visible
+	b.WriteString(m.renderTerminalHeader())
+	b.WriteString("\n\n")
+
+	confirmBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(warningColor).
+		Padding(2, 4).
+		Width(m.width - 4).
+		Render(fmt.Sprintf("⚠️  %s\n\n%s\n\n%s",
+			m.terminal.closePrompt,
+			keyStyle.Render("[y]")+" Yes, close connection  |  "+keyStyle.Render("[n]")+" No, cancel",
+			keyStyle.Render("[esc]")+" Cancel"))
+
+	b.WriteString(lipgloss.Place(m.width, m.height-6, lipgloss.Center, lipgloss.Center, confirmBox))
+
+	return b.String()
+}
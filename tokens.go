@@ -0,0 +1,262 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"gopkg.in/yaml.v3"
+)
+
+// tokenFileName is the default token file looked up in the dogoctl config directory
+const tokenFileName = "token"
+
+// tokenCommandTimeout bounds how long a credential helper may run
+const tokenCommandTimeout = 10 * time.Second
+
+// errTokenNotFound is returned by providers that have no token to offer,
+// letting the chain fall through to the next provider
+var errTokenNotFound = errors.New("token not found")
+
+// tokenProvider is a single source of a DigitalOcean API token
+type tokenProvider interface {
+	source() string // Human-readable description, shown on startup
+	token() (string, error)
+}
+
+// staticTokenProvider returns a token written directly in config.yaml
+type staticTokenProvider struct {
+	value string
+}
+
+func (p staticTokenProvider) source() string { return "config.yaml" }
+
+func (p staticTokenProvider) token() (string, error) {
+	return p.value, nil
+}
+
+// envTokenProvider reads the token from an environment variable
+type envTokenProvider struct {
+	name string
+}
+
+func (p envTokenProvider) source() string { return "$" + p.name }
+
+func (p envTokenProvider) token() (string, error) {
+	token := strings.TrimSpace(os.Getenv(p.name))
+	if token == "" {
+		return "", fmt.Errorf("%s environment variable is not set: %w", p.name, errTokenNotFound)
+	}
+	return token, nil
+}
+
+// doctlTokenProvider reads the token from doctl's config file.
+// An empty context uses doctl's own current context.
+type doctlTokenProvider struct {
+	context string
+}
+
+// doctlConfig is the subset of doctl's config.yaml dogoctl understands
+type doctlConfig struct {
+	AccessToken  string            `yaml:"access-token"`
+	AuthContexts map[string]string `yaml:"auth-contexts"`
+	Context      string            `yaml:"context"`
+}
+
+// doctlConfigPath returns the location of doctl's config.yaml
+func doctlConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "doctl", "config.yaml")
+}
+
+func (p doctlTokenProvider) source() string {
+	if p.context != "" {
+		return fmt.Sprintf("doctl config (context %s)", p.context)
+	}
+	return "doctl config"
+}
+
+func (p doctlTokenProvider) token() (string, error) {
+	path := doctlConfigPath()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%s does not exist: %w", path, errTokenNotFound)
+		}
+		return "", fmt.Errorf("failed to read %s: %v", path, err)
+	}
+
+	var cfg doctlConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return "", fmt.Errorf("invalid doctl config %s: %v", path, err)
+	}
+
+	name := p.context
+	if name == "" {
+		name = cfg.Context
+	}
+	token := cfg.AccessToken
+	if name != "" && name != defaultContextName {
+		token = cfg.AuthContexts[name]
+	}
+	if token == "" {
+		return "", fmt.Errorf("doctl config has no token for context %q: %w", name, errTokenNotFound)
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// fileTokenProvider reads the token from a file that only the owner can access
+type fileTokenProvider struct {
+	path string
+}
+
+func (p fileTokenProvider) source() string { return "file " + p.path }
+
+func (p fileTokenProvider) token() (string, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%s does not exist: %w", p.path, errTokenNotFound)
+		}
+		return "", err
+	}
+	// Refuse tokens other users could read (permission bits aren't meaningful on Windows)
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return "", fmt.Errorf("file is accessible by other users (mode %04o), run: chmod 600 %s",
+			info.Mode().Perm(), p.path)
+	}
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("file is empty")
+	}
+	return token, nil
+}
+
+// commandTokenProvider runs an external credential helper that prints a token
+type commandTokenProvider struct {
+	command string
+}
+
+func (p commandTokenProvider) source() string {
+	return fmt.Sprintf("credential helper (%s)", p.command)
+}
+
+func (p commandTokenProvider) token() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", p.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.command)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("credential helper failed: %v: %s", err, msg)
+		}
+		return "", fmt.Errorf("credential helper failed: %v", err)
+	}
+
+	// Only the first line is used so helpers can print trailing notes
+	token := strings.TrimSpace(strings.SplitN(string(output), "\n", 2)[0])
+	if token == "" {
+		return "", fmt.Errorf("credential helper printed no token")
+	}
+	return token, nil
+}
+
+// tokenProviders returns the providers to try for the context, in order.
+// Sources configured on the context are used exclusively; otherwise the
+// default chain is DO_TOKEN, doctl's config, then ~/.config/dogoctl/token.
+func (ctx *Context) tokenProviders() []tokenProvider {
+	var providers []tokenProvider
+	if ctx.Token != "" {
+		providers = append(providers, staticTokenProvider{value: ctx.Token})
+	}
+	if ctx.TokenEnv != "" {
+		providers = append(providers, envTokenProvider{name: ctx.TokenEnv})
+	}
+	if ctx.TokenFile != "" {
+		providers = append(providers, fileTokenProvider{path: expandHome(ctx.TokenFile)})
+	}
+	if ctx.TokenCommand != "" {
+		providers = append(providers, commandTokenProvider{command: ctx.TokenCommand})
+	}
+	if ctx.DoctlContext != "" {
+		providers = append(providers, doctlTokenProvider{context: ctx.DoctlContext})
+	}
+	if len(providers) > 0 {
+		return providers
+	}
+
+	providers = []tokenProvider{
+		envTokenProvider{name: "DO_TOKEN"},
+		doctlTokenProvider{},
+	}
+	if dir := configDir(); dir != "" {
+		providers = append(providers, fileTokenProvider{path: filepath.Join(dir, tokenFileName)})
+	}
+	return providers
+}
+
+// token walks the context's provider chain and returns the first token found,
+// along with a description of where it came from.
+// Providers that fail for reasons other than "no token" stop the chain, so a
+// misconfigured source is reported instead of silently skipped.
+func (ctx *Context) token() (string, string, error) {
+	var tried []string
+	for _, provider := range ctx.tokenProviders() {
+		token, err := provider.token()
+		if err == nil {
+			return token, provider.source(), nil
+		}
+		if !errors.Is(err, errTokenNotFound) {
+			return "", "", fmt.Errorf("%s: %v", provider.source(), err)
+		}
+		tried = append(tried, provider.source())
+	}
+	return "", "", fmt.Errorf("no API token found (tried %s)", strings.Join(tried, ", "))
+}
+
+// expandHome expands a leading ~/ to the user's home directory
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
+
+// isAuthError reports whether err is a 401 from the DigitalOcean API
+func isAuthError(err error) bool {
+	var errResp *godo.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode == http.StatusUnauthorized
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"gopkg.in/yaml.v3"
+)
+
+// maxUserDataSize is the API's limit on droplet user data
+const maxUserDataSize = 64 * 1024
+
+// userDataTemplateDir is the directory under the config directory holding named cloud-init templates
+const userDataTemplateDir = "cloud-init"
+
+// validateUserData checks user data before it's sent. #cloud-config must be
+// valid YAML; scripts and other cloud-init formats are passed through.
+func validateUserData(data string) error {
+	if data == "" {
+		return nil
+	}
+	if len(data) > maxUserDataSize {
+		return fmt.Errorf("user data is %s, the limit is %s", formatByteSize(int64(len(data))), formatByteSize(maxUserDataSize))
+	}
+	firstLine, _, _ := strings.Cut(data, "\n")
+	firstLine = strings.TrimSpace(firstLine)
+	switch {
+	case firstLine == "#cloud-config":
+		var config map[string]interface{}
+		if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+			return fmt.Errorf("user data is not valid #cloud-config YAML: %v", err)
+		}
+		return nil
+	case strings.HasPrefix(firstLine, "#!"), strings.HasPrefix(firstLine, "#include"),
+		strings.HasPrefix(firstLine, "#cloud-boothook"), strings.HasPrefix(firstLine, "Content-Type:"):
+		return nil
+	}
+	return fmt.Errorf("user data must start with #cloud-config or a #! script line (got %q)", truncateString(firstLine, 40))
+}
+
+// readUserDataFile reads and validates a user data file
+func readUserDataFile(path string) (string, error) {
+	data, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", fmt.Errorf("user data: %w", err)
+	}
+	if err := validateUserData(string(data)); err != nil {
+		return "", fmt.Errorf("%s: %w", path, err)
+	}
+	return string(data), nil
+}
+
+// resolveUserData reads user data from a file, or from the template with that name
+func resolveUserData(ref string) (string, error) {
+	if _, err := os.Stat(expandHome(ref)); err != nil {
+		if path, ok := userDataTemplates()[ref]; ok {
+			return readUserDataFile(path)
+		}
+	}
+	return readUserDataFile(ref)
+}
+
+// userDataTemplates returns the template files in the config directory, by name (file name without extension)
+func userDataTemplates() map[string]string {
+	dir := configDir()
+	if dir == "" {
+		return nil
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, userDataTemplateDir))
+	if err != nil {
+		return nil
+	}
+	templates := make(map[string]string)
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), filepath.Ext(e.Name()))
+		templates[name] = filepath.Join(dir, userDataTemplateDir, e.Name())
+	}
+	return templates
+}
+
+// setUserData sets the create form's user data and where it came from
+func (m *model) setUserData(data, source string) {
+	m.userData = data
+	m.userDataSource = source
+	if data == "" {
+		m.userDataSource = ""
+	}
+}
+
+// userDataLabel describes the create form's user data
+func (m *model) userDataLabel() string {
+	if m.userData == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s (%d lines, %s)", m.userDataSource, strings.Count(strings.TrimRight(m.userData, "\n"), "\n")+1, formatByteSize(int64(len(m.userData))))
+}
+
+// openUserDataMenu offers the ways to set the create form's user data
+func (m *model) openUserDataMenu() {
+	noTemplates := ""
+	if len(userDataTemplates()) == 0 {
+		noTemplates = fmt.Sprintf("no templates in %s", filepath.Join(configDir(), userDataTemplateDir))
+	}
+	noUserData := ""
+	if m.userData == "" {
+		noUserData = "no user data set"
+	}
+	m.menu = &menuDialog{
+		title:    "📜 User Data",
+		subtitle: "cloud-init config or script run on first boot",
+		items: []menuItem{
+			{key: "1", label: "Load a file", run: func(m *model) tea.Cmd {
+				m.input = newInputDialog("📜 User data file", "e.g. ~/cloud-init/web.yaml", "", func(m *model, path string) tea.Cmd {
+					data, err := readUserDataFile(path)
+					if err != nil {
+						m.err = err
+						return nil
+					}
+					m.setUserData(data, filepath.Base(path))
+					return nil
+				})
+				return nil
+			}},
+			{key: "2", label: "Use a template", disabled: noTemplates, run: func(m *model) tea.Cmd {
+				m.openUserDataTemplatePicker()
+				return nil
+			}},
+			{key: "3", label: "Edit inline", run: func(m *model) tea.Cmd {
+				return m.openUserDataEditor()
+			}},
+			{key: "4", label: "Clear", disabled: noUserData, run: func(m *model) tea.Cmd {
+				m.setUserData("", "")
+				return nil
+			}},
+		},
+	}
+}
+
+func (m *model) openUserDataTemplatePicker() {
+	templates := userDataTemplates()
+	names := make([]string, 0, len(templates))
+	for name := range templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	options := make([]pickerOption, len(names))
+	for i, name := range names {
+		options[i] = pickerOption{label: fmt.Sprintf("%s (%s)", name, filepath.Base(templates[name])), value: name}
+	}
+	m.picker = &pickerDialog{
+		title:   "📜 User data template",
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			data, err := readUserDataFile(templates[option.value])
+			if err != nil {
+				m.err = err
+				return nil
+			}
+			m.setUserData(data, "template "+option.value)
+			return nil
+		},
+	}
+}
+
+// openUserDataEditor opens the multi-line editor on the current user data,
+// starting a #cloud-config document if there is none
+func (m *model) openUserDataEditor() tea.Cmd {
+	editor := textarea.New()
+	editor.ShowLineNumbers = true
+	editor.CharLimit = maxUserDataSize
+	editor.MaxHeight = 0
+	editor.SetWidth(max(40, m.width-4))
+	editor.SetHeight(max(5, m.height-getTopPadding()-6))
+	editor.Cursor.SetMode(cursor.CursorStatic)
+	value := m.userData
+	if value == "" {
+		value = "#cloud-config\n"
+	}
+	editor.SetValue(value)
+	m.userDataEditor = &editor
+	return m.userDataEditor.Focus()
+}
+
+func (m model) updateUserDataEditor(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.userDataEditor = nil
+		return m, nil
+	case "ctrl+s":
+		value := m.userDataEditor.Value()
+		if strings.TrimSpace(value) == "" || strings.TrimSpace(value) == "#cloud-config" {
+			m.setUserData("", "")
+			m.userDataEditor = nil
+			return m, nil
+		}
+		if !strings.HasSuffix(value, "\n") {
+			value += "\n"
+		}
+		// Keep the editor open on errors so they can be fixed
+		if err := validateUserData(value); err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.err = nil
+		m.setUserData(value, "inline")
+		m.userDataEditor = nil
+		return m, nil
+	}
+	editor, cmd := m.userDataEditor.Update(msg)
+	m.userDataEditor = &editor
+	return m, cmd
+}
+
+func (m model) renderUserDataEditor() string {
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(headerStyle.Render("📜 Edit User Data"))
+	s.WriteString("\n\n")
+	s.WriteString(m.userDataEditor.View())
+	s.WriteString("\n")
+	if m.err != nil {
+		s.WriteString(errorMessageStyle.Render(fmt.Sprintf("❌ %v", m.err)))
+		s.WriteString("\n")
+	}
+	s.WriteString(helpStyle.Render("[ctrl+s] Save  [esc] Cancel"))
+	return s.String()
+}
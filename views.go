@@ -0,0 +1,271 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// resourceView is a top-level list view opened from command mode (e.g. :snapshots).
+// Each resource file registers its view in init().
+type resourceView struct {
+	name          string                                     // View name, also the command that opens it
+	aliases       []string                                   // Extra commands that open the view
+	title         string                                     // e.g. "Snapshots"
+	load          func(m *model) tea.Cmd                     // Loads (or reloads) the view's data
+	count         func(m *model) int                         // Number of items, for the status bar
+	updateTable   func(m *model)                             // Sets columns and rows on m.table
+	handleKey     func(m *model, key string) (tea.Cmd, bool) // View-specific keys, returns true if handled
+	keys          []keyHint                                  // View-specific keys shown in the top bar
+	renderDetails func(m model) string                       // Details for the selected row (<enter>), optional
+	detailsKey    func(m *model, key string) (tea.Cmd, bool) // Extra keys on the details screen, optional
+}
+
+// keyHint is a key and what it does, for the top bar
+type keyHint struct {
+	key   string
+	label string
+}
+
+// columnSpec describes a responsive table column
+type columnSpec struct {
+	title      string
+	proportion float64 // Share of the available width
+	min        int     // Minimum width
+}
+
+var resourceViews = map[string]*resourceView{}
+
+func registerResourceView(rv *resourceView) {
+	resourceViews[rv.name] = rv
+}
+
+// findResourceView returns the view opened by the command, or nil
+func findResourceView(command string) *resourceView {
+	if rv, ok := resourceViews[command]; ok {
+		return rv
+	}
+	for _, rv := range resourceViews {
+		for _, alias := range rv.aliases {
+			if alias == command {
+				return rv
+			}
+		}
+	}
+	return nil
+}
+
+// resourceViewNames returns the commands that open resource views, sorted
+func resourceViewNames() []string {
+	names := make([]string, 0, len(resourceViews))
+	for name := range resourceViews {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resourceView returns the current view if it's a registered resource view
+func (m *model) resourceView() *resourceView {
+	return resourceViews[m.currentView]
+}
+
+// switchToResourceView makes rv the current view and loads its data
+func (m *model) switchToResourceView(rv *resourceView) tea.Cmd {
+	m.stopClusterWatch()
+	m.currentView = rv.name
+	m.viewingResourceDetails = false
+	m.detailsScroll = 0
+	m.err = nil
+	m.loading = true
+	m.table.SetCursor(0)
+	m.updateTableRows()
+	return tea.Batch(rv.load(m), m.spinner.Tick)
+}
+
+// updateResourceView handles keys in a resource view. Keys the view doesn't
+// handle fall through to the global handler only if they are global keys.
+func (m model) updateResourceView(rv *resourceView, msg tea.KeyMsg) (tea.Model, tea.Cmd, bool) {
+	key := msg.String()
+
+	if m.viewingResourceDetails {
+		if rv.detailsKey != nil {
+			if cmd, handled := rv.detailsKey(&m, key); handled {
+				return m, cmd, true
+			}
+		}
+		switch key {
+		case "esc", "enter", "backspace":
+			m.viewingResourceDetails = false
+			m.detailsScroll = 0
+		case "up", "k":
+			if m.detailsScroll > 0 {
+				m.detailsScroll--
+			}
+		case "down", "j":
+			m.detailsScroll++
+		case "pgup", "ctrl+b":
+			m.detailsScroll = max(0, m.detailsScroll-10)
+		case "pgdown", "ctrl+f":
+			m.detailsScroll += 10
+		case "home", "g":
+			m.detailsScroll = 0
+		case "end", "G":
+			m.detailsScroll = 9999
+		case "ctrl+c", "q":
+			return m, tea.Quit, true
+		}
+		return m, nil, true
+	}
+
+	if rv.handleKey != nil {
+		if cmd, handled := rv.handleKey(&m, key); handled {
+			return m, cmd, true
+		}
+	}
+
+	switch key {
+	case "r", "R":
+		m.loading = true
+		return m, tea.Batch(rv.load(&m), m.spinner.Tick), true
+	case "enter":
+		if rv.renderDetails != nil && len(m.table.SelectedRow()) > 0 {
+			m.viewingResourceDetails = true
+			m.detailsScroll = 0
+		}
+		return m, nil, true
+	case "esc":
+		m.currentView = viewDroplets
+		m.updateTableRows()
+		return m, nil, true
+	case ":", "ctrl+c", "q", "1", "2", "3":
+		// Global keys
+		return m, nil, false
+	}
+
+	// Everything else is table navigation
+	var cmd tea.Cmd
+	if !m.loading {
+		m.table, cmd = m.table.Update(msg)
+	}
+	return m, cmd, true
+}
+
+// responsiveColumns sizes columns to the table width, scaling down when they don't fit
+func responsiveColumns(width int, specs []columnSpec) []table.Column {
+	tableWidth := width - 2
+	if tableWidth < 50 {
+		tableWidth = 50
+	}
+	availableWidth := tableWidth - 6 // Account for table borders
+
+	columns := make([]table.Column, len(specs))
+	total := 0
+	for i, spec := range specs {
+		columns[i] = table.Column{Title: spec.title, Width: max(int(float64(availableWidth)*spec.proportion), spec.min)}
+		total += columns[i].Width
+	}
+
+	// Ensure total doesn't exceed available width
+	if total > availableWidth {
+		scale := float64(availableWidth) / float64(total)
+		for i := range columns {
+			columns[i].Width = max(int(float64(columns[i].Width)*scale), 3)
+		}
+	}
+	return columns
+}
+
+// setTableData sets columns and rows, truncating values to fit and adding a
+// placeholder row when there is no data
+func (m *model) setTableData(columns []table.Column, rows []table.Row) {
+	for _, row := range rows {
+		for i := range row {
+			if i < len(columns) {
+				row[i] = truncateString(row[i], columns[i].Width)
+			}
+		}
+	}
+	if len(rows) == 0 {
+		placeholder := make(table.Row, len(columns))
+		for i := range placeholder {
+			placeholder[i] = ""
+		}
+		placeholder[0] = "No data"
+		rows = []table.Row{placeholder}
+	}
+
+	// Clear rows first so old rows are never rendered with the new columns
+	m.table.SetRows([]table.Row{})
+	m.table.SetColumns(columns)
+	m.table.SetRows(rows)
+	if m.table.Cursor() >= len(rows) {
+		m.table.SetCursor(len(rows) - 1)
+	}
+}
+
+// selectedIndex returns the cursor position if it points at one of n items, or -1
+func (m *model) selectedIndex(n int) int {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= n {
+		return -1
+	}
+	return cursor
+}
+
+// renderKeys renders the view's key hints, each key formatted with keyFormat
+// (e.g. "%s" or "<%s>") and separated by sep
+func (rv *resourceView) renderKeys(keyFormat, sep string) string {
+	hints := append([]keyHint{}, rv.keys...)
+	hints = append(hints, keyHint{"r", "Refresh"}, keyHint{"esc", "Back"}, keyHint{"q", "Quit"})
+	if rv.renderDetails != nil {
+		hints = append([]keyHint{{"enter", "Details"}}, hints...)
+	}
+	parts := make([]string, len(hints))
+	for i, hint := range hints {
+		parts[i] = keyStyle.Render(fmt.Sprintf(keyFormat, hint.key)) + " " + hint.label
+	}
+	return strings.Join(parts, sep)
+}
+
+// renderResourceDetails renders the details screen, scrolled by m.detailsScroll
+func (m model) renderResourceDetails(rv *resourceView) string {
+	content := rv.renderDetails(m)
+	lines := strings.Split(content, "\n")
+
+	// Leave room for the help line
+	visible := m.height - getTopPadding() - 3
+	if visible < 5 {
+		visible = 5
+	}
+	maxScroll := max(0, len(lines)-visible)
+	scroll := min(m.detailsScroll, maxScroll)
+	end := min(scroll+visible, len(lines))
+
+	var s strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	s.WriteString(strings.Join(lines[scroll:end], "\n"))
+	s.WriteString("\n")
+	help := "[↑/↓] Scroll  [esc] Back"
+	if maxScroll > 0 {
+		help = fmt.Sprintf("%s  (%d/%d)", help, scroll+1, maxScroll+1)
+	}
+	s.WriteString(helpStyle.Render(help))
+	return s.String()
+}
+
+// renderDetailsSection renders a titled block of label/value pairs
+func renderDetailsSection(title string, pairs [][2]string) string {
+	var s strings.Builder
+	s.WriteString(headerStyle.Render(title))
+	s.WriteString("\n")
+	for _, pair := range pairs {
+		s.WriteString(labelStyle.Render(pair[0]+": ") + valueStyle.Render(pair[1]))
+		s.WriteString("\n")
+	}
+	return lipgloss.NewStyle().MarginBottom(1).Render(s.String())
+}
@@ -0,0 +1,409 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+// maxVolumeSizeGB is the largest block storage volume DigitalOcean allows
+const maxVolumeSizeGB = 16384
+
+type volumesLoadedMsg []godo.Volume
+
+// volumeChangedMsg reports a finished volume operation; the list is reloaded
+type volumeChangedMsg string
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewVolumes,
+		aliases: []string{"volume", "vol"},
+		title:   "Volumes",
+		load: func(m *model) tea.Cmd {
+			return loadVolumes(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.projectVolumes())
+		},
+		updateTable: (*model).updateVolumeTable,
+		handleKey:   (*model).handleVolumeKey,
+		keys: []keyHint{
+			{"c", "Create"},
+			{"a", "Attach"},
+			{"d", "Detach"},
+			{"e", "Resize"},
+			{"s", "Snapshot"},
+		},
+		renderDetails: model.renderVolumeDetails,
+	})
+}
+
+func loadVolumes(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		volumes, err := fetchVolumes(context.Background(), client, reportProgress(progressChan, client, "volumes"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return volumesLoadedMsg(volumes)
+	})
+}
+
+func createVolume(client *godo.Client, req *godo.VolumeCreateRequest) tea.Cmd {
+	return func() tea.Msg {
+		volume, _, err := client.Storage.CreateVolume(context.Background(), req)
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to create volume %s: %w (region: %s, size: %dGB)", req.Name, err, req.Region, req.SizeGigaBytes))
+		}
+		return volumeChangedMsg(fmt.Sprintf("✅ Volume '%s' created in %s", volume.Name, req.Region))
+	}
+}
+
+func snapshotVolume(client *godo.Client, volume godo.Volume, name string) tea.Cmd {
+	return func() tea.Msg {
+		_, _, err := client.Storage.CreateSnapshot(context.Background(), &godo.SnapshotCreateRequest{
+			VolumeID: volume.ID,
+			Name:     name,
+		})
+		if err != nil {
+			return errMsg(fmt.Errorf("failed to snapshot volume %s: %w", volume.Name, err))
+		}
+		return volumeChangedMsg(fmt.Sprintf("✅ Snapshot '%s' created from volume '%s'", name, volume.Name))
+	}
+}
+
+// selectedVolume returns the volume under the cursor
+func (m *model) selectedVolume() (godo.Volume, bool) {
+	volumes := m.projectVolumes()
+	i := m.selectedIndex(len(volumes))
+	if i < 0 {
+		return godo.Volume{}, false
+	}
+	return volumes[i], true
+}
+
+// dropletName returns the name of the droplet with the ID, or the ID if it isn't loaded
+func (m *model) dropletName(id int) string {
+	for _, d := range m.droplets {
+		if d.ID == id {
+			return d.Name
+		}
+	}
+	return strconv.Itoa(id)
+}
+
+// attachedVolumeNames returns "name (size)" for each volume attached to the droplet
+func (m *model) attachedVolumeNames(d godo.Droplet) []string {
+	names := make([]string, 0, len(d.VolumeIDs))
+	for _, id := range d.VolumeIDs {
+		name := id
+		for _, v := range m.volumes {
+			if v.ID == id {
+				name = fmt.Sprintf("%s (%dGB)", v.Name, v.SizeGigaBytes)
+				break
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func (m *model) volumeAttachments(v godo.Volume) string {
+	if len(v.DropletIDs) == 0 {
+		return "-"
+	}
+	names := make([]string, len(v.DropletIDs))
+	for i, id := range v.DropletIDs {
+		names[i] = m.dropletName(id)
+	}
+	return strings.Join(names, ",")
+}
+
+func volumeRegion(v godo.Volume) string {
+	if v.Region == nil {
+		return ""
+	}
+	return v.Region.Slug
+}
+
+func volumeFilesystem(v godo.Volume) string {
+	if v.FilesystemType == "" {
+		return "unformatted"
+	}
+	if v.FilesystemLabel != "" {
+		return fmt.Sprintf("%s (%s)", v.FilesystemType, v.FilesystemLabel)
+	}
+	return v.FilesystemType
+}
+
+func (m *model) updateVolumeTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.25, 15},
+		{"SIZE", 0.10, 8},
+		{"REGION", 0.10, 8},
+		{"FILESYSTEM", 0.15, 10},
+		{"ATTACHED TO", 0.25, 12},
+		{"CREATED", 0.15, 10},
+	})
+
+	var rows []table.Row
+	for _, v := range m.projectVolumes() {
+		rows = append(rows, table.Row{
+			v.Name,
+			fmt.Sprintf("%dGB", v.SizeGigaBytes),
+			volumeRegion(v),
+			volumeFilesystem(v),
+			m.volumeAttachments(v),
+			v.CreatedAt.Local().Format("2006-01-02 15:04"),
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m *model) handleVolumeKey(key string) (tea.Cmd, bool) {
+	if key == "c" || key == "C" {
+		return m.startVolumeCreate(), true
+	}
+
+	var handler func(v godo.Volume) tea.Cmd
+	switch key {
+	case "a", "A":
+		handler = m.openVolumeAttachPicker
+	case "d", "D":
+		handler = m.openVolumeDetach
+	case "e", "E":
+		handler = m.promptVolumeResize
+	case "s", "S":
+		handler = m.promptVolumeSnapshot
+	default:
+		return nil, false
+	}
+	v, ok := m.selectedVolume()
+	if !ok {
+		return nil, true
+	}
+	return handler(v), true
+}
+
+// startVolumeCreate runs the create flow: region, name, size, filesystem.
+// Regions are loaded first if the create form hasn't loaded them yet.
+func (m *model) startVolumeCreate() tea.Cmd {
+	m.err = nil
+	if len(m.availableRegions) == 0 {
+		m.pendingVolumeCreate = true
+		m.loading = true
+		return tea.Batch(loadRegions(m.client, m.pageProgressChan), m.spinner.Tick)
+	}
+	m.openVolumeRegionPicker()
+	return nil
+}
+
+// openVolumeRegionPicker offers the regions that support block storage,
+// starting at the default region
+func (m *model) openVolumeRegionPicker() {
+	var options []pickerOption
+	cursor := 0
+	for _, r := range m.availableRegions {
+		if !r.Available || !containsString(r.Features, "storage") {
+			continue
+		}
+		if r.Slug == m.defaultRegion() {
+			cursor = len(options)
+		}
+		options = append(options, pickerOption{label: fmt.Sprintf("%s (%s)", r.Slug, r.Name), value: r.Slug})
+	}
+	m.picker = &pickerDialog{
+		title:   "💽 New Volume - Region",
+		options: options,
+		cursor:  cursor,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			m.promptVolumeName(option.value)
+			return nil
+		},
+	}
+}
+
+func (m *model) promptVolumeName(region string) {
+	defaultName := fmt.Sprintf("volume-%s-%s", region, time.Now().Format("20060102"))
+	m.input = newInputDialog("💽 New Volume - Name", "volume name", defaultName, func(m *model, name string) tea.Cmd {
+		m.input = newInputDialog("💽 New Volume - Size (GB)", "size in GB", "100", func(m *model, value string) tea.Cmd {
+			size, err := strconv.Atoi(value)
+			if err != nil || size < 1 || size > maxVolumeSizeGB {
+				m.err = fmt.Errorf("volume size must be between 1 and %d GB, got %q", maxVolumeSizeGB, value)
+				return nil
+			}
+			m.picker = &pickerDialog{
+				title: "💽 New Volume - Filesystem",
+				options: []pickerOption{
+					{label: "ext4", value: "ext4"},
+					{label: "xfs", value: "xfs"},
+					{label: "Unformatted", value: ""},
+				},
+				onPick: func(m *model, option pickerOption) tea.Cmd {
+					m.loading = true
+					return tea.Batch(createVolume(m.client, &godo.VolumeCreateRequest{
+						Region:         region,
+						Name:           name,
+						SizeGigaBytes:  int64(size),
+						FilesystemType: option.value,
+					}), m.spinner.Tick)
+				},
+			}
+			return nil
+		})
+		return nil
+	})
+}
+
+// startVolumeAction runs a single volume action, tracked like droplet actions
+func (m *model) startVolumeAction(v godo.Volume, dropletID int, title string, run func(ctx context.Context, c *godo.Client) (*godo.Action, *godo.Response, error)) tea.Cmd {
+	if m.runningAction != nil {
+		m.err = fmt.Errorf("another action is in progress")
+		return nil
+	}
+	m.runningAction = &trackedAction{
+		client:     m.client,
+		dropletID:  dropletID,
+		targetName: v.Name,
+		title:      title,
+		steps: []dropletActionStep{{label: title, run: func(ctx context.Context, c *godo.Client, _ int) (*godo.Action, *godo.Response, error) {
+			return run(ctx, c)
+		}}},
+		poll: func(ctx context.Context, c *godo.Client, actionID int) (*godo.Action, *godo.Response, error) {
+			return c.StorageActions.Get(ctx, v.ID, actionID)
+		},
+		onDone: func(m *model) tea.Cmd {
+			return loadVolumes(m.client, m.pageProgressChan)
+		},
+	}
+	m.err = nil
+	m.successMsg = ""
+	return tea.Batch(runDropletActionStep(m.client, dropletID, m.runningAction.steps[0]), m.spinner.Tick)
+}
+
+// openVolumeAttachPicker offers the droplets in the volume's region
+func (m *model) openVolumeAttachPicker(v godo.Volume) tea.Cmd {
+	if len(v.DropletIDs) > 0 {
+		m.err = fmt.Errorf("volume %s is already attached to %s, detach it first", v.Name, m.volumeAttachments(v))
+		return nil
+	}
+	var options []pickerOption
+	for _, d := range m.droplets {
+		if d.Region == nil || d.Region.Slug != volumeRegion(v) {
+			continue
+		}
+		options = append(options, pickerOption{
+			label: fmt.Sprintf("%s (%s, %s)", d.Name, d.Status, d.SizeSlug),
+			value: strconv.Itoa(d.ID),
+		})
+	}
+	m.picker = &pickerDialog{
+		title:   fmt.Sprintf("🔗 Attach %s to a droplet in %s", v.Name, volumeRegion(v)),
+		options: options,
+		onPick: func(m *model, option pickerOption) tea.Cmd {
+			dropletID, _ := strconv.Atoi(option.value)
+			return m.startVolumeAction(v, dropletID, "Attach to "+m.dropletName(dropletID), func(ctx context.Context, c *godo.Client) (*godo.Action, *godo.Response, error) {
+				return c.StorageActions.Attach(ctx, v.ID, dropletID)
+			})
+		},
+	}
+	return nil
+}
+
+// openVolumeDetach asks before detaching the volume from its droplet
+func (m *model) openVolumeDetach(v godo.Volume) tea.Cmd {
+	if len(v.DropletIDs) == 0 {
+		m.err = fmt.Errorf("volume %s is not attached", v.Name)
+		return nil
+	}
+	dropletID := v.DropletIDs[0]
+	m.confirm = &confirmDialog{
+		title: "Detach Volume?",
+		lines: []string{
+			fmt.Sprintf("Volume: %s", v.Name),
+			fmt.Sprintf("Droplet: %s", m.dropletName(dropletID)),
+		},
+		warning: "Unmount the volume on the droplet first to avoid data loss.",
+		onConfirm: func(m *model) tea.Cmd {
+			return m.startVolumeAction(v, dropletID, "Detach from "+m.dropletName(dropletID), func(ctx context.Context, c *godo.Client) (*godo.Action, *godo.Response, error) {
+				return c.StorageActions.DetachByDropletID(ctx, v.ID, dropletID)
+			})
+		},
+	}
+	return nil
+}
+
+// promptVolumeResize asks for the new size; volumes can only grow
+func (m *model) promptVolumeResize(v godo.Volume) tea.Cmd {
+	title := fmt.Sprintf("📐 Resize %s (currently %dGB)", v.Name, v.SizeGigaBytes)
+	m.input = newInputDialog(title, "new size in GB", strconv.FormatInt(v.SizeGigaBytes, 10), func(m *model, value string) tea.Cmd {
+		size, err := strconv.Atoi(value)
+		if err != nil || int64(size) <= v.SizeGigaBytes || size > maxVolumeSizeGB {
+			m.err = fmt.Errorf("new size must be between %d and %d GB, got %q (volumes can't shrink)", v.SizeGigaBytes+1, maxVolumeSizeGB, value)
+			return nil
+		}
+		m.confirm = &confirmDialog{
+			title: "Resize Volume?",
+			lines: []string{
+				fmt.Sprintf("Volume: %s", v.Name),
+				fmt.Sprintf("Size: %dGB → %dGB", v.SizeGigaBytes, size),
+			},
+			warning: "Volumes can't be shrunk afterwards. Grow the filesystem on the droplet to use the new space.",
+			onConfirm: func(m *model) tea.Cmd {
+				return m.startVolumeAction(v, 0, fmt.Sprintf("Resize to %dGB", size), func(ctx context.Context, c *godo.Client) (*godo.Action, *godo.Response, error) {
+					return c.StorageActions.Resize(ctx, v.ID, size, volumeRegion(v))
+				})
+			},
+		}
+		return nil
+	})
+	return nil
+}
+
+func (m *model) promptVolumeSnapshot(v godo.Volume) tea.Cmd {
+	defaultName := fmt.Sprintf("%s-%s", v.Name, time.Now().Format("20060102-1504"))
+	m.input = newInputDialog("📸 Snapshot "+v.Name, "snapshot name", defaultName, func(m *model, name string) tea.Cmd {
+		m.loading = true
+		return tea.Batch(snapshotVolume(m.client, v, name), m.spinner.Tick)
+	})
+	return nil
+}
+
+func (m model) renderVolumeDetails() string {
+	v, ok := m.selectedVolume()
+	if !ok {
+		return ""
+	}
+	description := v.Description
+	if description == "" {
+		description = "none"
+	}
+	tags := strings.Join(v.Tags, ", ")
+	if tags == "" {
+		tags = "none"
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("💽 Volume: %s", v.Name)))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("Volume", [][2]string{
+		{"ID", v.ID},
+		{"Size", fmt.Sprintf("%dGB", v.SizeGigaBytes)},
+		{"Region", volumeRegion(v)},
+		{"Filesystem", volumeFilesystem(v)},
+		{"Description", description},
+		{"Tags", tags},
+		{"Created", v.CreatedAt.Local().Format("2006-01-02 15:04")},
+	}))
+	b.WriteString("\n")
+	b.WriteString(renderDetailsSection("Attachment", [][2]string{
+		{"Droplet", m.volumeAttachments(v)},
+	}))
+	return b.String()
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/digitalocean/godo"
+)
+
+type vpcsLoadedMsg []*godo.VPC
+
+// vpcMembersMsg reports the members of a VPC
+type vpcMembersMsg struct {
+	vpcID   string
+	members []*godo.VPCMember
+}
+
+func init() {
+	registerResourceView(&resourceView{
+		name:    viewVPCs,
+		aliases: []string{"vpc"},
+		title:   "VPCs",
+		load: func(m *model) tea.Cmd {
+			return loadVPCs(m.client, m.pageProgressChan)
+		},
+		count: func(m *model) int {
+			return len(m.vpcs)
+		},
+		updateTable:   (*model).updateVPCTable,
+		renderDetails: model.renderVPCDetails,
+	})
+}
+
+func loadVPCs(client *godo.Client, progressChan chan<- tea.Msg) tea.Cmd {
+	return withClient(client, func() tea.Msg {
+		vpcs, err := fetchVPCs(context.Background(), client, reportProgress(progressChan, client, "vpcs"))
+		if err != nil {
+			return errMsg(err)
+		}
+		return vpcsLoadedMsg(vpcs)
+	})
+}
+
+// loadVPCMembers loads the resources in each VPC
+func loadVPCMembers(client *godo.Client, vpcs []*godo.VPC) tea.Cmd {
+	cmds := make([]tea.Cmd, len(vpcs))
+	for i, vpc := range vpcs {
+		vpcID := vpc.ID
+		cmds[i] = withClient(client, func() tea.Msg {
+			members, err := listAll(context.Background(), defaultPerPage, func(ctx context.Context, opt *godo.ListOptions) ([]*godo.VPCMember, *godo.Response, error) {
+				return client.VPCs.ListMembers(ctx, vpcID, nil, opt)
+			}, nil)
+			if err != nil {
+				return errMsg(fmt.Errorf("failed to list members of VPC %s: %w", vpcID, err))
+			}
+			return vpcMembersMsg{vpcID: vpcID, members: members}
+		})
+	}
+	return tea.Batch(cmds...)
+}
+
+// vpcByID returns the VPC with the ID, or nil if it isn't loaded
+func (m *model) vpcByID(id string) *godo.VPC {
+	for _, vpc := range m.vpcs {
+		if vpc.ID == id {
+			return vpc
+		}
+	}
+	return nil
+}
+
+// selectedVPC returns the VPC under the cursor
+func (m *model) selectedVPC() *godo.VPC {
+	i := m.selectedIndex(len(m.vpcs))
+	if i < 0 {
+		return nil
+	}
+	return m.vpcs[i]
+}
+
+// vpcMemberType returns the resource type from a member URN, e.g. "do:droplet:123" -> "droplet"
+func vpcMemberType(urn string) string {
+	parts := strings.Split(urn, ":")
+	if len(parts) < 3 {
+		return urn
+	}
+	return parts[1]
+}
+
+func (m *model) vpcMemberSummary(vpcID string) string {
+	members, ok := m.vpcMembers[vpcID]
+	if !ok {
+		return "..."
+	}
+	if len(members) == 0 {
+		return "-"
+	}
+	counts := map[string]int{}
+	var types []string
+	for _, member := range members {
+		t := vpcMemberType(member.URN)
+		if counts[t] == 0 {
+			types = append(types, t)
+		}
+		counts[t]++
+	}
+	parts := make([]string, len(types))
+	for i, t := range types {
+		parts[i] = fmt.Sprintf("%d %s", counts[t], t)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func (m *model) updateVPCTable() {
+	columns := responsiveColumns(m.width, []columnSpec{
+		{"NAME", 0.22, 15},
+		{"REGION", 0.08, 6},
+		{"IP RANGE", 0.15, 12},
+		{"DEFAULT", 0.08, 7},
+		{"MEMBERS", 0.27, 12},
+		{"ID", 0.20, 12},
+	})
+
+	var rows []table.Row
+	for _, vpc := range m.vpcs {
+		isDefault := ""
+		if vpc.Default {
+			isDefault = "yes"
+		}
+		rows = append(rows, table.Row{
+			vpc.Name,
+			vpc.RegionSlug,
+			vpc.IPRange,
+			isDefault,
+			m.vpcMemberSummary(vpc.ID),
+			vpc.ID,
+		})
+	}
+	m.setTableData(columns, rows)
+}
+
+func (m model) renderVPCDetails() string {
+	vpc := m.selectedVPC()
+	if vpc == nil {
+		return ""
+	}
+	description := vpc.Description
+	if description == "" {
+		description = "none"
+	}
+
+	var b strings.Builder
+	// Note: Top padding is applied globally in View(), not here
+	b.WriteString(headerStyle.Render(fmt.Sprintf("🔒 VPC: %s", vpc.Name)))
+	b.WriteString("\n\n")
+	b.WriteString(renderDetailsSection("VPC", [][2]string{
+		{"ID", vpc.ID},
+		{"Region", vpc.RegionSlug},
+		{"IP Range", vpc.IPRange},
+		{"Default", strconv.FormatBool(vpc.Default)},
+		{"Description", description},
+		{"Created", vpc.CreatedAt.Local().Format("2006-01-02 15:04")},
+	}))
+	b.WriteString("\n")
+
+	var members [][2]string
+	for _, member := range m.vpcMembers[vpc.ID] {
+		members = append(members, [2]string{vpcMemberType(member.URN), member.Name})
+	}
+	title := fmt.Sprintf("Members (%d)", len(members))
+	if _, ok := m.vpcMembers[vpc.ID]; !ok {
+		title = "Members (loading...)"
+	}
+	b.WriteString(renderDetailsSection(title, members))
+	return b.String()
+}